@@ -20,6 +20,9 @@ func main() {
 	if os.Getenv("USE_ENV_FILE") == "" {
 		env.Initialize()
 	}
+	if os.Getenv("REJOIN_TOKEN_SECRET") == "" {
+		log.Fatal("REJOIN_TOKEN_SECRET is not set")
+	}
 
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
 
@@ -47,7 +50,7 @@ func main() {
 	qServ := q.NewService(qRepo, uRepo)
 	quizHandler := q.NewHandler(qServ)
 
-	hub := l.NewHub()
+	hub := l.NewHub(cacheConn.GetCache())
 	lRepo := l.NewRepository(dbConn.GetDB(), cacheConn.GetCache())
 	lServ := l.NewService(lRepo, uRepo)
 
@@ -58,6 +61,7 @@ func main() {
 	dashboardHandler := d.NewHandler(dashboardServ, qServ, lServ, uServ)
 
 	go hub.Run()
+	go liveHandler.RunScheduler(ctx)
 	router.Initialize(userHandler, quizHandler, liveHandler, dashboardHandler)
 
 	port := os.Getenv("PORT")
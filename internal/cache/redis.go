@@ -46,11 +46,7 @@ func (c *Cache) Clear() error {
 func (c *Cache) Close() {
 	log.Println("Closing cache connection")
 
-	err := c.Clear()
-	if err != nil {
-		panic(err)
-	}
-
+	// The cache is shared by every replica, so it is left intact on shutdown.
 	er := c.cache.Close()
 	if er != nil {
 		panic(er)
@@ -13,4 +13,8 @@ func DashboardRoutes(r *gin.RouterGroup, h *d.Handler) {
 	dashboard.GET("", h.GetDashboardHistoryByUserID)
 	dashboard.GET("/question/:id", h.GetDashboardQuestionViewByID)
 	dashboard.GET("/answer/:id", h.GetDashboardAnswerViewByID)
+	dashboard.GET("/grading/:id", h.GetDashboardGradingViewByID)
+	dashboard.PATCH("/grading/:id/responses/:rid", h.GradeDashboardAnswerResponse)
+	dashboard.GET("/grading/:id/responses/:rid/history", h.GetDashboardAnswerResponseGrades)
+	dashboard.POST("/regrade/:id", h.RegradeDashboardQuestion)
 }
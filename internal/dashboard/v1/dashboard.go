@@ -16,6 +16,9 @@ type AnswerResponse struct {
 	QuestionID        uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid"`
 	Answer            string         `json:"answer" gorm:"column:answer;type:text"`
 	UseTime           int            `json:"use_time" gorm:"column:use_time;type:int"`
+	Marks             int            `json:"marks" gorm:"column:marks;type:int"`
+	Comment           string         `json:"comment" gorm:"column:comment;type:text"`
+	GradedAt          *time.Time     `json:"graded_at" gorm:"column:graded_at;type:timestamp"`
 	CreatedAt         time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
 	UpdatedAt         time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
 	DeletedAt         gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
@@ -25,6 +28,24 @@ func (AnswerResponse) TableName() string {
 	return "answer_response"
 }
 
+// AnswerResponseGrade records a change a host made to the marks of an answer
+// response after the session.
+type AnswerResponseGrade struct {
+	ID               uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	AnswerResponseID uuid.UUID      `json:"answer_response_id" gorm:"column:answer_response_id;type:uuid;not null;references:answer_response(id)"`
+	GraderID         uuid.UUID      `json:"grader_id" gorm:"column:grader_id;type:uuid;not null;references:user(id)"`
+	PreviousMarks    int            `json:"previous_marks" gorm:"column:previous_marks;type:int"`
+	Marks            int            `json:"marks" gorm:"column:marks;type:int"`
+	Comment          string         `json:"comment" gorm:"column:comment;type:text"`
+	CreatedAt        time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt        time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt        gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+}
+
+func (AnswerResponseGrade) TableName() string {
+	return "answer_response_grade"
+}
+
 type Participant struct {
 	ID                uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey"`
 	UserID            *uuid.UUID     `json:"user_id" gorm:"column:user_id;type:uuid"`
@@ -101,6 +122,7 @@ type AnswerViewQuestionResponse struct {
 	Mark      int       `json:"mark"`
 	IsCorrect bool      `json:"is_correct"`
 	UseTime   int       `json:"use_time"`
+	Comment   string    `json:"comment"`
 }
 
 type LiveAnswerRequest struct {
@@ -136,6 +158,8 @@ type QuestionViewQuestionResponse struct {
 	FontSize       int           `json:"font_size"`
 	SelectMin      int           `json:"select_min"`
 	SelectMax      int           `json:"select_max"`
+	SelectGrading  string        `json:"select_grading"`
+	Voided         bool          `json:"voided"`
 	Options        []interface{} `json:"options"`
 }
 
@@ -174,6 +198,55 @@ type QuestionViewParticipant struct {
 	Name string
 }
 
+type GradingViewResponse struct {
+	ID        uuid.UUID                     `json:"id"`
+	Title     string                        `json:"title"`
+	Questions []GradingViewQuestionResponse `json:"questions"`
+}
+
+type GradingViewQuestionResponse struct {
+	ID        uuid.UUID                     `json:"id"`
+	Order     int                           `json:"order"`
+	Content   string                        `json:"content"`
+	Mark      int                           `json:"mark"`
+	Responses []GradingViewResponseResponse `json:"responses"`
+}
+
+type GradingViewResponseResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Participant string     `json:"participant"`
+	Answer      string     `json:"answer"`
+	Marks       int        `json:"marks"`
+	Comment     string     `json:"comment"`
+	GradedAt    *time.Time `json:"graded_at"`
+	UseTime     int        `json:"use_time"`
+}
+
+type GradeAnswerResponseRequest struct {
+	Marks   *int   `json:"marks"`
+	Comment string `json:"comment"`
+}
+
+type GradeAnswerResponseResponse struct {
+	AnswerResponse
+	ParticipantMarks int `json:"participant_marks"`
+}
+
+type AnswerResponseGradeResponse struct {
+	AnswerResponseGrade
+}
+
+type RegradeQuestionRequest struct {
+	QuestionID uuid.UUID              `json:"question_id"`
+	Options    []RegradeOptionRequest `json:"options"`
+}
+
+type RegradeOptionRequest struct {
+	ID      uuid.UUID `json:"id"`
+	Correct bool      `json:"correct"`
+	Mark    int       `json:"mark"`
+}
+
 type SessionHistory struct {
 	ID                uuid.UUID      `json:"id"`
 	CreatorName       string         `json:"creator_name"`
@@ -196,8 +269,18 @@ type Repository interface {
 	GetAnswerResponsesByLiveQuizSessionIDAndQuestionHistoryID(ctx context.Context, liveQuizSessionID uuid.UUID, questionID uuid.UUID) ([]AnswerResponse, error)
 	GetAnswerResponsesByLiveQuizSessionIDAndParticipantID(ctx context.Context, liveQuizSessionID uuid.UUID, participantID uuid.UUID) ([]AnswerResponse, error)
 
+	GetAnswerResponsesByLiveQuizSessionIDAndType(ctx context.Context, liveQuizSessionID uuid.UUID, qType string) ([]AnswerResponse, error)
+	GetAnswerResponseByID(ctx context.Context, id uuid.UUID) (*AnswerResponse, error)
+	LockAnswerResponse(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*AnswerResponse, error)
+	BackfillAnswerResponseMarks(ctx context.Context, tx *gorm.DB, id uuid.UUID, marks int) error
+	UpdateAnswerResponse(ctx context.Context, tx *gorm.DB, answerResponse *AnswerResponse) (*AnswerResponse, error)
+
 	GetParticipantByID(ctx context.Context, participantID uuid.UUID) (*Participant, error)
 	GetOrderParticipantsByLiveQuizSessionID(ctx context.Context, liveQuizSessionID uuid.UUID) ([]Participant, error)
+	AddParticipantMarks(ctx context.Context, tx *gorm.DB, id uuid.UUID, marks int) (*Participant, error)
+
+	CreateAnswerResponseGrade(ctx context.Context, tx *gorm.DB, grade *AnswerResponseGrade) (*AnswerResponseGrade, error)
+	GetAnswerResponseGradesByAnswerResponseID(ctx context.Context, answerResponseID uuid.UUID) ([]AnswerResponseGrade, error)
 }
 
 // #################### SERVICE START ####################
@@ -205,6 +288,11 @@ type Service interface {
 	GetAnswerResponsesByLiveQuizSessionIDAndQuestionHistoryID(ctx context.Context, liveQuizSessionID uuid.UUID, questionID uuid.UUID) ([]LiveAnswerResponse, error)
 	GetAnswerResponsesByLiveQuizSessionIDAndParticipantID(ctx context.Context, liveQuizSessionID uuid.UUID, participantID uuid.UUID) ([]LiveAnswerResponse, error)
 
+	GetAnswerResponsesByLiveQuizSessionIDAndType(ctx context.Context, liveQuizSessionID uuid.UUID, qType string) ([]LiveAnswerResponse, error)
+	GetAnswerResponseByID(ctx context.Context, id uuid.UUID) (*LiveAnswerResponse, error)
+	GradeAnswerResponse(ctx context.Context, req *GradeAnswerResponseRequest, id uuid.UUID, graderID uuid.UUID, legacyMarks int) (*GradeAnswerResponseResponse, error)
+	GetAnswerResponseGradesByAnswerResponseID(ctx context.Context, answerResponseID uuid.UUID) ([]AnswerResponseGradeResponse, error)
+
 	GetParticipantByID(ctx context.Context, liveQuizSessionID uuid.UUID) (*Participant, error)
 	GetOrderParticipantsByLiveQuizSessionID(ctx context.Context, liveQuizSessionID uuid.UUID) ([]ParticipantResponse, error)
 	CountTotalParticipants(ctx context.Context, liveQuizSessionID uuid.UUID) (int, error)
@@ -1,7 +1,13 @@
 package v1
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
 	"net/http"
+	"slices"
+	"sort"
 	"strings"
 
 	l "github.com/Live-Quiz-Project/Backend/internal/live/v1"
@@ -93,7 +99,7 @@ func (h *Handler) GetDashboardQuestionViewByID(c *gin.Context) {
 	}
 
 	for _, qr := range questionH {
-		if qr.Type == util.Choice || qr.Type == util.TrueFalse {
+		if qr.Type == util.Choice || qr.Type == util.TrueFalse || qr.Type == util.Poll {
 			ocRes, err := h.quizService.GetChoiceOptionHistoriesByQuestionID(c.Request.Context(), qr.ID)
 			if err != nil {
 				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -156,6 +162,7 @@ func (h *Handler) GetDashboardQuestionViewByID(c *gin.Context) {
 				FontSize:       qr.FontSize,
 				SelectMin:      qr.SelectMin,
 				SelectMax:      qr.SelectMax,
+				SelectGrading:  qr.SelectGrading,
 				Options:        oc,
 			})
 		}
@@ -222,6 +229,7 @@ func (h *Handler) GetDashboardQuestionViewByID(c *gin.Context) {
 				FontSize:       qr.FontSize,
 				SelectMin:      qr.SelectMin,
 				SelectMax:      qr.SelectMax,
+				SelectGrading:  qr.SelectGrading,
 				Options:        ot,
 			})
 		}
@@ -316,6 +324,7 @@ func (h *Handler) GetDashboardQuestionViewByID(c *gin.Context) {
 				FontSize:       qr.FontSize,
 				SelectMin:      qr.SelectMin,
 				SelectMax:      qr.SelectMax,
+				SelectGrading:  qr.SelectGrading,
 				Options:        om,
 			})
 		}
@@ -331,6 +340,11 @@ func (h *Handler) GetDashboardQuestionViewByID(c *gin.Context) {
 		}
 	}
 
+	exempted := util.ExemptedIDs(lqs.ExemptedQuestionIDs)
+	for i := range res.Questions {
+		res.Questions[i].Voided = slices.Contains(exempted, res.Questions[i].ID.String())
+	}
+
 	c.JSON(http.StatusOK, res)
 }
 
@@ -374,6 +388,8 @@ func (h *Handler) GetDashboardAnswerViewByID(c *gin.Context) {
 		CreatedAt:   quizH.CreatedAt,
 	}
 
+	exempted := util.ExemptedIDs(lqs.ExemptedQuestionIDs)
+
 	participants, err := h.Service.GetOrderParticipantsByLiveQuizSessionID(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -395,6 +411,10 @@ func (h *Handler) GetDashboardAnswerViewByID(c *gin.Context) {
 		var isCorrect bool
 
 		for _, a := range answers {
+			// Voided questions count for nobody.
+			if slices.Contains(exempted, a.QuestionID.String()) {
+				continue
+			}
 			var checkIsCorrectAnswer = 0
 			ansList := strings.Split(a.Answer, util.AnswerSplitter)
 			answerString := strings.Join(ansList, ", ")
@@ -408,12 +428,14 @@ func (h *Handler) GetDashboardAnswerViewByID(c *gin.Context) {
 
 			if a.Type == util.Choice || a.Type == util.TrueFalse {
 				var convertIDToStringAnswer []string
+				picked := make(map[uuid.UUID]bool)
 				for _, ans := range ansList {
 					ans, err := uuid.Parse(ans)
 					if err != nil {
 						c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
 						return
 					}
+					picked[ans] = true
 					optionInfo, err := h.quizService.GetChoiceOptionHistoryByQuestionIDAndChoiceOptionID(c, a.QuestionID, ans)
 					if err != nil {
 						c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -434,6 +456,31 @@ func (h *Handler) GetDashboardAnswerViewByID(c *gin.Context) {
 					isCorrect = false
 				}
 
+				// Multi-select questions graded as a whole need every option,
+				// not only the picked ones.
+				if util.IsSelectGraded(q.SelectGrading) {
+					options, err := h.quizService.GetChoiceOptionHistoriesByQuestionID(c.Request.Context(), a.QuestionID)
+					if err != nil {
+						c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+						return
+					}
+
+					var selection []util.SelectOption
+					corrects := 0
+					for _, o := range options {
+						selection = append(selection, util.SelectOption{
+							Mark:    o.Mark,
+							Correct: o.Correct,
+							Picked:  picked[o.ID],
+						})
+						if o.Correct {
+							corrects += 1
+						}
+					}
+					questionMark = int(math.Round(util.GradeSelection(q.SelectGrading, selection)))
+					isCorrect = isCorrect && checkIsCorrectAnswer == corrects
+				}
+
 				totalMarks += questionMark
 				totalTimeUsed += a.UseTime
 
@@ -448,7 +495,24 @@ func (h *Handler) GetDashboardAnswerViewByID(c *gin.Context) {
 					UseTime:   a.UseTime,
 				})
 			}
-			if a.Type == util.FillBlank || a.Type == util.Paragraph {
+			if a.Type == util.Paragraph && a.GradedAt != nil {
+				questionMark = a.Marks
+				isCorrect = a.Marks > 0
+				totalMarks += questionMark
+				totalTimeUsed += a.UseTime
+
+				questions = append(questions, AnswerViewQuestionResponse{
+					ID:        a.ID,
+					Type:      q.Type,
+					Order:     q.Order,
+					Content:   q.Content,
+					Answer:    a.Answer,
+					Mark:      questionMark,
+					IsCorrect: isCorrect,
+					UseTime:   a.UseTime,
+					Comment:   a.Comment,
+				})
+			} else if a.Type == util.FillBlank || a.Type == util.Paragraph {
 				for _, ans := range ansList {
 					optionInfo, err := h.quizService.GetTextOptionHistoryByQuestionIDAndContent(c.Request.Context(), a.QuestionID, ans)
 					if err != nil {
@@ -583,6 +647,416 @@ func (h *Handler) GetDashboardAnswerViewByID(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
+func (h *Handler) GetDashboardGradingViewByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id")) // id = live_quiz_session_id
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	uid, ok := c.Get("uid")
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	userID, err := uuid.Parse(uid.(string))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	lqs, err := h.liveService.GetLiveQuizSessionBySessionID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if lqs.HostID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only the host can grade this session"})
+		return
+	}
+
+	quizH, err := h.quizService.GetQuizHistoryByID(c.Request.Context(), lqs.QuizID, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	participants, err := h.Service.GetOrderParticipantsByLiveQuizSessionID(c.Request.Context(), lqs.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	names := make(map[uuid.UUID]string)
+	for _, p := range participants {
+		names[p.ID] = p.Name
+	}
+
+	answers, err := h.Service.GetAnswerResponsesByLiveQuizSessionIDAndType(c.Request.Context(), lqs.ID, util.Paragraph)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	questionH, err := h.quizService.GetQuestionHistoriesByQuizID(c.Request.Context(), lqs.QuizID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	res := GradingViewResponse{
+		ID:        lqs.ID,
+		Title:     quizH.Title,
+		Questions: make([]GradingViewQuestionResponse, 0),
+	}
+	for _, qr := range questionH {
+		if qr.Type != util.Paragraph {
+			continue
+		}
+
+		otRes, err := h.quizService.GetTextOptionHistoriesByQuestionID(c.Request.Context(), qr.ID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		mark := 0
+		for _, otr := range otRes {
+			mark = max(mark, otr.Mark)
+		}
+
+		responses := make([]GradingViewResponseResponse, 0)
+		for _, a := range answers {
+			if a.QuestionID != qr.ID {
+				continue
+			}
+			responses = append(responses, GradingViewResponseResponse{
+				ID:          a.ID,
+				Participant: names[a.ParticipantID],
+				Answer:      a.Answer,
+				Marks:       a.Marks,
+				Comment:     a.Comment,
+				GradedAt:    a.GradedAt,
+				UseTime:     a.UseTime,
+			})
+		}
+
+		res.Questions = append(res.Questions, GradingViewQuestionResponse{
+			ID:        qr.ID,
+			Order:     qr.Order,
+			Content:   qr.Content,
+			Mark:      mark,
+			Responses: responses,
+		})
+	}
+	sort.SliceStable(res.Questions, func(i, j int) bool {
+		return res.Questions[i].Order < res.Questions[j].Order
+	})
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *Handler) GradeDashboardAnswerResponse(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id")) // id = live_quiz_session_id
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	rid, err := uuid.Parse(c.Param("rid")) // rid = answer_response_id
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid response id"})
+		return
+	}
+
+	var req GradeAnswerResponseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Marks == nil || *req.Marks < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "marks must be zero or more"})
+		return
+	}
+
+	uid, ok := c.Get("uid")
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	userID, err := uuid.Parse(uid.(string))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	lqs, err := h.liveService.GetLiveQuizSessionBySessionID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if lqs.HostID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only the host can grade this session"})
+		return
+	}
+
+	answer, err := h.Service.GetAnswerResponseByID(c.Request.Context(), rid)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "response not found"})
+		return
+	}
+	if answer.LiveQuizSessionID != lqs.ID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "response not found"})
+		return
+	}
+	if answer.Type != util.Paragraph {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "only paragraph responses can be graded"})
+		return
+	}
+	if slices.Contains(util.ExemptedIDs(lqs.ExemptedQuestionIDs), answer.QuestionID.String()) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "the question was voided"})
+		return
+	}
+
+	legacyMarks, err := h.legacyParagraphMarks(c.Request.Context(), answer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.Service.GradeAnswerResponse(c.Request.Context(), &req, rid, userID, legacyMarks)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// legacyParagraphMarks works out the marks a paragraph response saved before
+// marks were stored with it was given back then: the first answer's mark and
+// time bonus on an exact match, nothing otherwise.
+func (h *Handler) legacyParagraphMarks(ctx context.Context, answer *LiveAnswerResponse) (int, error) {
+	if answer.GradedAt != nil || answer.Marks != 0 {
+		return answer.Marks, nil
+	}
+
+	question, err := h.quizService.GetQuestionHistoryByID(ctx, answer.QuestionID)
+	if err != nil {
+		return 0, err
+	}
+	options, err := h.quizService.GetTextOptionHistoriesByQuestionID(ctx, answer.QuestionID)
+	if err != nil {
+		return 0, err
+	}
+	if len(options) == 0 {
+		return 0, nil
+	}
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].Order < options[j].Order
+	})
+
+	o := options[0]
+	if !((o.CaseSensitive && o.Content == answer.Answer) || (!o.CaseSensitive && strings.EqualFold(o.Content, answer.Answer))) {
+		return 0, nil
+	}
+	timeBonus := 0.0
+	if o.Mark > 0 && question.HaveTimeFactor {
+		timeBonus = (float64(question.TimeLimit*10) - float64(answer.UseTime)) / 10 * float64(question.TimeFactor)
+	}
+	return int(math.Round(float64(o.Mark) + timeBonus)), nil
+}
+
+func (h *Handler) GetDashboardAnswerResponseGrades(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id")) // id = live_quiz_session_id
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	rid, err := uuid.Parse(c.Param("rid")) // rid = answer_response_id
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid response id"})
+		return
+	}
+
+	uid, ok := c.Get("uid")
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	userID, err := uuid.Parse(uid.(string))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	lqs, err := h.liveService.GetLiveQuizSessionBySessionID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if lqs.HostID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only the host can grade this session"})
+		return
+	}
+
+	answer, err := h.Service.GetAnswerResponseByID(c.Request.Context(), rid)
+	if err != nil || answer.LiveQuizSessionID != lqs.ID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "response not found"})
+		return
+	}
+
+	res, err := h.Service.GetAnswerResponseGradesByAnswerResponseID(c.Request.Context(), rid)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// cachedAnswers turns an answer key into the shape it comes out of the
+// session cache in, which is what the live scoring reads.
+func cachedAnswers(key []q.LQSChoiceAnswer) ([]any, error) {
+	var answers []any
+	b, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &answers); err != nil {
+		return nil, err
+	}
+	return answers, nil
+}
+
+func (h *Handler) RegradeDashboardQuestion(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id")) // id = live_quiz_session_id
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	var req RegradeQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(req.Options) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "the corrected answer key is empty"})
+		return
+	}
+
+	uid, ok := c.Get("uid")
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	userID, err := uuid.Parse(uid.(string))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return
+	}
+
+	lqs, err := h.liveService.GetLiveQuizSessionBySessionID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if lqs.HostID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only the host can regrade this session"})
+		return
+	}
+
+	qh, err := h.quizService.GetQuestionHistoryByID(c.Request.Context(), req.QuestionID)
+	if err != nil || qh.QuizID != lqs.QuizID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "question not found"})
+		return
+	}
+	if qh.Type != util.Choice && qh.Type != util.TrueFalse {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "only choice and true/false questions can be regraded"})
+		return
+	}
+	if slices.Contains(util.ExemptedIDs(lqs.ExemptedQuestionIDs), qh.ID.String()) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "the question was voided"})
+		return
+	}
+
+	// Questions in a pool are timed by the pool.
+	timeLimit, haveTimeFactor, timeFactor := qh.TimeLimit, qh.HaveTimeFactor, qh.TimeFactor
+	if qh.QuestionPoolID != nil {
+		pools, err := h.quizService.GetQuestionPoolHistoriesByQuizID(c.Request.Context(), lqs.QuizID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		for _, qp := range pools {
+			if qp.ID == *qh.QuestionPoolID {
+				timeLimit, haveTimeFactor, timeFactor = qp.TimeLimit, qp.HaveTimeFactor, qp.TimeFactor
+			}
+		}
+	}
+	if !haveTimeFactor {
+		timeFactor = 0
+	}
+
+	// The answer key the question was played with is shared by every session
+	// of the quiz, so the correction only applies to the marks of this one.
+	key, err := h.quizService.GetAnswersByQuestionHistoryIDForLQS(c.Request.Context(), qh.Type, qh.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	original, ok := key.([]q.LQSChoiceAnswer)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "unexpected answer key"})
+		return
+	}
+	corrected := slices.Clone(original)
+	for _, o := range req.Options {
+		i := slices.IndexFunc(corrected, func(a q.LQSChoiceAnswer) bool { return a.ID == o.ID })
+		if i < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid option " + o.ID.String()})
+			return
+		}
+		corrected[i].Correct = o.Correct
+		corrected[i].Mark = o.Mark
+	}
+
+	answers, err := cachedAnswers(corrected)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	played, err := cachedAnswers(original)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.liveService.RegradeQuestion(c.Request.Context(), lqs.ID, &l.RegradeQuestion{
+		ID:            qh.ID,
+		Type:          qh.Type,
+		TimeLimit:     float64(timeLimit),
+		TimeFactor:    float64(timeFactor),
+		SelectGrading: qh.SelectGrading,
+		Answers:       answers,
+		Original:      played,
+	})
+	if errors.Is(err, l.ErrSessionRunning) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
 func (h *Handler) GetDashboardHistoryByUserID(c *gin.Context) {
 	uid, ok := c.Get("uid")
 	if !ok {
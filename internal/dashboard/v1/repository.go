@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repository struct {
@@ -45,6 +46,56 @@ func (r *repository) GetAnswerResponsesByLiveQuizSessionIDAndParticipantID(ctx c
 	return answerResponses, nil
 }
 
+func (r *repository) GetAnswerResponsesByLiveQuizSessionIDAndType(ctx context.Context, liveQuizSessionID uuid.UUID, qType string) ([]AnswerResponse, error) {
+	var answerResponses []AnswerResponse
+	res := r.db.WithContext(ctx).Where("live_quiz_session_id = ? AND type = ?", liveQuizSessionID, qType).Order("created_at ASC").Find(&answerResponses)
+	if res.Error != nil {
+		return []AnswerResponse{}, res.Error
+	}
+	return answerResponses, nil
+}
+
+func (r *repository) GetAnswerResponseByID(ctx context.Context, id uuid.UUID) (*AnswerResponse, error) {
+	var answerResponse AnswerResponse
+	res := r.db.WithContext(ctx).Where("id = ?", id).First(&answerResponse)
+	if res.Error != nil {
+		return &AnswerResponse{}, res.Error
+	}
+	return &answerResponse, nil
+}
+
+// LockAnswerResponse reads an answer response and holds it until tx ends so
+// that graders cannot overwrite each other.
+func (r *repository) LockAnswerResponse(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*AnswerResponse, error) {
+	var answerResponse AnswerResponse
+	res := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&answerResponse)
+	if res.Error != nil {
+		tx.Rollback()
+		return &AnswerResponse{}, res.Error
+	}
+	return &answerResponse, nil
+}
+
+// BackfillAnswerResponseMarks sets the marks of an answer response saved
+// before marks were stored with it. Graded responses are left alone.
+func (r *repository) BackfillAnswerResponseMarks(ctx context.Context, tx *gorm.DB, id uuid.UUID, marks int) error {
+	res := tx.WithContext(ctx).Model(&AnswerResponse{}).Where("id = ? AND marks IS NULL", id).Update("marks", marks)
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+	return nil
+}
+
+func (r *repository) UpdateAnswerResponse(ctx context.Context, tx *gorm.DB, answerResponse *AnswerResponse) (*AnswerResponse, error) {
+	res := tx.WithContext(ctx).Save(answerResponse)
+	if res.Error != nil {
+		tx.Rollback()
+		return &AnswerResponse{}, res.Error
+	}
+	return answerResponse, nil
+}
+
 func (r *repository) GetParticipantByID(ctx context.Context, participantID uuid.UUID) (*Participant, error) {
 	var participant Participant
 	res := r.db.WithContext(ctx).Where("id = ?", participantID).Find(&participant)
@@ -62,3 +113,33 @@ func(r *repository) GetOrderParticipantsByLiveQuizSessionID(ctx context.Context,
 	}
 	return participant, nil
 }
+
+// AddParticipantMarks moves the marks of a participant by marks in the
+// database itself and returns the participant as it is afterwards.
+func (r *repository) AddParticipantMarks(ctx context.Context, tx *gorm.DB, id uuid.UUID, marks int) (*Participant, error) {
+	var participant Participant
+	res := tx.WithContext(ctx).Model(&participant).Clauses(clause.Returning{}).Where("id = ?", id).Update("marks", gorm.Expr("marks + ?", marks))
+	if res.Error != nil {
+		tx.Rollback()
+		return &Participant{}, res.Error
+	}
+	return &participant, nil
+}
+
+func (r *repository) CreateAnswerResponseGrade(ctx context.Context, tx *gorm.DB, grade *AnswerResponseGrade) (*AnswerResponseGrade, error) {
+	res := tx.WithContext(ctx).Create(grade)
+	if res.Error != nil {
+		tx.Rollback()
+		return &AnswerResponseGrade{}, res.Error
+	}
+	return grade, nil
+}
+
+func (r *repository) GetAnswerResponseGradesByAnswerResponseID(ctx context.Context, answerResponseID uuid.UUID) ([]AnswerResponseGrade, error) {
+	var grades []AnswerResponseGrade
+	res := r.db.WithContext(ctx).Where("answer_response_id = ?", answerResponseID).Order("created_at ASC").Find(&grades)
+	if res.Error != nil {
+		return []AnswerResponseGrade{}, res.Error
+	}
+	return grades, nil
+}
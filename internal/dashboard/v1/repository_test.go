@@ -203,3 +203,265 @@ func TestGetOrderParticipantsByLiveQuizSessionID(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Nil(t, mock.ExpectationsWereMet())
 }
+
+func TestGetAnswerResponsesByLiveQuizSessionIDAndType(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &AnswerResponse{
+		ID:                uuid.New(),
+		LiveQuizSessionID: uuid.New(),
+		ParticipantID:     uuid.New(),
+		Type:              "PARAGRAPH",
+		QuestionID:        uuid.New(),
+		Answer:            "Answer",
+		UseTime:           5,
+		Marks:             0,
+	}
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "live_quiz_session_id", "participant_id", "type", "question_id", "answer", "use_time", "marks"}).
+		AddRow(data.ID.String(), data.LiveQuizSessionID.String(), data.ParticipantID.String(), data.Type, data.QuestionID.String(), data.Answer, data.UseTime, data.Marks)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"answer_response\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.LiveQuizSessionID, data.Type).
+		WillReturnRows(sample)
+
+	// Actual Function
+	res, err := repo.GetAnswerResponsesByLiveQuizSessionIDAndType(context.TODO(), data.LiveQuizSessionID, data.Type)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAnswerResponseByID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &AnswerResponse{
+		ID:                uuid.New(),
+		LiveQuizSessionID: uuid.New(),
+		ParticipantID:     uuid.New(),
+		Type:              "PARAGRAPH",
+		QuestionID:        uuid.New(),
+		Answer:            "Answer",
+		UseTime:           5,
+		Marks:             3,
+		Comment:           "Comment",
+	}
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "live_quiz_session_id", "participant_id", "type", "question_id", "answer", "use_time", "marks", "comment"}).
+		AddRow(data.ID.String(), data.LiveQuizSessionID.String(), data.ParticipantID.String(), data.Type, data.QuestionID.String(), data.Answer, data.UseTime, data.Marks, data.Comment)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"answer_response\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// Actual Function
+	res, err := repo.GetAnswerResponseByID(context.TODO(), data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, data.Marks, res.Marks)
+	assert.Equal(t, data.Comment, res.Comment)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateAnswerResponse(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &AnswerResponse{
+		ID:                uuid.New(),
+		LiveQuizSessionID: uuid.New(),
+		ParticipantID:     uuid.New(),
+		Type:              "PARAGRAPH",
+		QuestionID:        uuid.New(),
+		Answer:            "Answer",
+		UseTime:           5,
+		Marks:             3,
+		Comment:           "Comment",
+	}
+
+	// Expected Query
+	expectedSQL := "UPDATE \"answer_response\" SET (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.UpdateAnswerResponse(context.TODO(), db, data)
+
+	// Unit Test
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestLockAnswerResponse(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	id := uuid.New()
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"answer_response\" WHERE id = (.+) FOR UPDATE"
+	mock.ExpectBegin()
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "marks"}).AddRow(id.String(), 7))
+	mock.ExpectCommit()
+
+	// Actual Function
+	tx := db.Begin()
+	res, err := repo.LockAnswerResponse(context.TODO(), tx, id)
+	tx.Commit()
+
+	// Unit Test
+	assert.Nil(t, err)
+	assert.Equal(t, 7, res.Marks)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestBackfillAnswerResponseMarks(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	id := uuid.New()
+
+	// Expected Query
+	expectedSQL := "UPDATE \"answer_response\" SET \"marks\"=(.+) WHERE \\(id = (.+) AND marks IS NULL\\)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(7, sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// Actual Function
+	tx := db.Begin()
+	err := repo.BackfillAnswerResponseMarks(context.TODO(), tx, id, 7)
+	tx.Commit()
+
+	// Unit Test
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestAddParticipantMarks(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	id := uuid.New()
+
+	// Expected Query
+	expectedSQL := "UPDATE \"participant\" SET \"marks\"=marks \\+ (.+) RETURNING \\*"
+	mock.ExpectBegin()
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(-3, sqlmock.AnyArg(), id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "marks"}).AddRow(id.String(), 97))
+	mock.ExpectCommit()
+
+	// Actual Function
+	tx := db.Begin()
+	res, err := repo.AddParticipantMarks(context.TODO(), tx, id, -3)
+	tx.Commit()
+
+	// Unit Test
+	assert.Nil(t, err)
+	assert.Equal(t, 97, res.Marks)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateAnswerResponseGrade(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &AnswerResponseGrade{
+		ID:               uuid.New(),
+		AnswerResponseID: uuid.New(),
+		GraderID:         uuid.New(),
+		PreviousMarks:    0,
+		Marks:            5,
+		Comment:          "Comment",
+	}
+
+	// Expected Query
+	expectedSQL := "INSERT INTO \"answer_response_grade\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateAnswerResponseGrade(context.TODO(), db, data)
+
+	// Unit Test
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAnswerResponseGradesByAnswerResponseID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &AnswerResponseGrade{
+		ID:               uuid.New(),
+		AnswerResponseID: uuid.New(),
+		GraderID:         uuid.New(),
+		PreviousMarks:    0,
+		Marks:            5,
+		Comment:          "Comment",
+	}
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "answer_response_id", "grader_id", "previous_marks", "marks", "comment"}).
+		AddRow(data.ID.String(), data.AnswerResponseID.String(), data.GraderID.String(), data.PreviousMarks, data.Marks, data.Comment)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"answer_response_grade\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.AnswerResponseID).
+		WillReturnRows(sample)
+
+	// Actual Function
+	res, err := repo.GetAnswerResponseGradesByAnswerResponseID(context.TODO(), data.AnswerResponseID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
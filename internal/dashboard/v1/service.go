@@ -142,4 +142,110 @@ func (s *service) CountTotalParticipants(ctx context.Context, liveQuizSessionID
 	totalParticipant := len(res)
 
 	return totalParticipant, err
-}
\ No newline at end of file
+}
+func (s *service) GetAnswerResponsesByLiveQuizSessionIDAndType(ctx context.Context, liveQuizSessionID uuid.UUID, qType string) ([]LiveAnswerResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	liveAnswers, err := s.Repository.GetAnswerResponsesByLiveQuizSessionIDAndType(c, liveQuizSessionID, qType)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []LiveAnswerResponse
+	for _, liveAnswer := range liveAnswers {
+		res = append(res, LiveAnswerResponse{
+			AnswerResponse: liveAnswer,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) GetAnswerResponseByID(ctx context.Context, id uuid.UUID) (*LiveAnswerResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	liveAnswer, err := s.Repository.GetAnswerResponseByID(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiveAnswerResponse{
+		AnswerResponse: *liveAnswer,
+	}, nil
+}
+
+// GradeAnswerResponse sets the marks of an answer response, moves the marks of
+// its participant by the difference and records the change, all in one
+// transaction. Responses saved before marks were stored with them are taken
+// to have legacyMarks.
+func (s *service) GradeAnswerResponse(ctx context.Context, req *GradeAnswerResponseRequest, id uuid.UUID, graderID uuid.UUID, legacyMarks int) (*GradeAnswerResponseResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	tx, err := s.Repository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Repository.BackfillAnswerResponseMarks(c, tx, id, legacyMarks); err != nil {
+		return nil, err
+	}
+	answerResponse, err := s.Repository.LockAnswerResponse(c, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	previousMarks := answerResponse.Marks
+	answerResponse.Marks = *req.Marks
+	answerResponse.Comment = req.Comment
+	answerResponse.GradedAt = &now
+
+	if _, err := s.Repository.UpdateAnswerResponse(c, tx, answerResponse); err != nil {
+		return nil, err
+	}
+	participant, err := s.Repository.AddParticipantMarks(c, tx, answerResponse.ParticipantID, answerResponse.Marks-previousMarks)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Repository.CreateAnswerResponseGrade(c, tx, &AnswerResponseGrade{
+		ID:               uuid.New(),
+		AnswerResponseID: answerResponse.ID,
+		GraderID:         graderID,
+		PreviousMarks:    previousMarks,
+		Marks:            answerResponse.Marks,
+		Comment:          req.Comment,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.Repository.CommitTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	return &GradeAnswerResponseResponse{
+		AnswerResponse:   *answerResponse,
+		ParticipantMarks: participant.Marks,
+	}, nil
+}
+
+func (s *service) GetAnswerResponseGradesByAnswerResponseID(ctx context.Context, answerResponseID uuid.UUID) ([]AnswerResponseGradeResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	grades, err := s.Repository.GetAnswerResponseGradesByAnswerResponseID(c, answerResponseID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]AnswerResponseGradeResponse, 0)
+	for _, grade := range grades {
+		res = append(res, AnswerResponseGradeResponse{
+			AnswerResponseGrade: grade,
+		})
+	}
+
+	return res, nil
+}
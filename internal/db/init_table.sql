@@ -27,6 +27,7 @@ CREATE TABLE IF NOT EXISTS quiz (
   mark INT,
   select_min INT,
   select_max INT,
+  select_grading TEXT,
   case_sensitive BOOLEAN,
   created_at TIMESTAMPTZ NOT NULL,
   updated_at TIMESTAMPTZ NOT NULL,
@@ -47,6 +48,7 @@ CREATE TABLE IF NOT EXISTS quiz_history (
   mark INT,
   select_min INT,
   select_max INT,
+  select_grading TEXT,
   case_sensitive BOOLEAN,
   created_at TIMESTAMPTZ NOT NULL,
   updated_at TIMESTAMPTZ NOT NULL,
@@ -108,6 +110,7 @@ CREATE TABLE IF NOT EXISTS question (
   layout_idx INT,
   select_min INT,
   select_max INT,
+  select_grading TEXT,
   created_at TIMESTAMPTZ NOT NULL,
   updated_at TIMESTAMPTZ NOT NULL,
   deleted_at TIMESTAMPTZ
@@ -133,6 +136,7 @@ CREATE TABLE IF NOT EXISTS question_history (
   layout_idx INT,
   select_min INT,
   select_max INT,
+  select_grading TEXT,
   created_at TIMESTAMPTZ NOT NULL,
   updated_at TIMESTAMPTZ NOT NULL,
   deleted_at TIMESTAMPTZ
@@ -169,6 +173,8 @@ CREATE TABLE IF NOT EXISTS option_text (
   content TEXT,
   mark INT,
   case_sensitive BOOLEAN,
+  match_mode TEXT,
+  match_threshold FLOAT,
   created_at TIMESTAMPTZ NOT NULL,
   updated_at TIMESTAMPTZ NOT NULL,
   deleted_at TIMESTAMPTZ
@@ -181,6 +187,124 @@ CREATE TABLE IF NOT EXISTS option_text_history (
   content TEXT,
   mark INT,
   case_sensitive BOOLEAN,
+  match_mode TEXT,
+  match_threshold FLOAT,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+CREATE TABLE IF NOT EXISTS option_numeric (
+  id UUID PRIMARY KEY NOT NULL,
+  question_id UUID NOT NULL REFERENCES question (id),
+  "order" INT,
+  value FLOAT,
+  tolerance FLOAT,
+  min_value FLOAT,
+  max_value FLOAT,
+  unit TEXT,
+  mark INT,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+CREATE TABLE IF NOT EXISTS option_numeric_history (
+  id UUID PRIMARY KEY NOT NULL,
+  option_numeric_id UUID NOT NULL REFERENCES option_numeric (id),
+  question_id UUID NOT NULL REFERENCES question_history (id),
+  "order" INT,
+  value FLOAT,
+  tolerance FLOAT,
+  min_value FLOAT,
+  max_value FLOAT,
+  unit TEXT,
+  mark INT,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+CREATE TABLE IF NOT EXISTS option_order (
+  id UUID PRIMARY KEY NOT NULL,
+  question_id UUID NOT NULL REFERENCES question (id),
+  "order" INT,
+  content TEXT,
+  color TEXT,
+  mark INT,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+CREATE TABLE IF NOT EXISTS option_order_history (
+  id UUID PRIMARY KEY NOT NULL,
+  option_order_id UUID NOT NULL REFERENCES option_order (id),
+  question_id UUID NOT NULL REFERENCES question_history (id),
+  "order" INT,
+  content TEXT,
+  color TEXT,
+  mark INT,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+CREATE TABLE IF NOT EXISTS option_hotspot (
+  id UUID PRIMARY KEY NOT NULL,
+  question_id UUID NOT NULL REFERENCES question (id),
+  "order" INT,
+  shape TEXT,
+  x FLOAT,
+  y FLOAT,
+  width FLOAT,
+  height FLOAT,
+  radius FLOAT,
+  points TEXT,
+  mark INT,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+CREATE TABLE IF NOT EXISTS option_hotspot_history (
+  id UUID PRIMARY KEY NOT NULL,
+  option_hotspot_id UUID NOT NULL REFERENCES option_hotspot (id),
+  question_id UUID NOT NULL REFERENCES question_history (id),
+  "order" INT,
+  shape TEXT,
+  x FLOAT,
+  y FLOAT,
+  width FLOAT,
+  height FLOAT,
+  radius FLOAT,
+  points TEXT,
+  mark INT,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+CREATE TABLE IF NOT EXISTS option_slider (
+  id UUID PRIMARY KEY NOT NULL,
+  question_id UUID NOT NULL REFERENCES question (id),
+  "order" INT,
+  min_value FLOAT,
+  max_value FLOAT,
+  step FLOAT,
+  target FLOAT,
+  tolerance FLOAT,
+  falloff FLOAT,
+  mark INT,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+CREATE TABLE IF NOT EXISTS option_slider_history (
+  id UUID PRIMARY KEY NOT NULL,
+  option_slider_id UUID NOT NULL REFERENCES option_slider (id),
+  question_id UUID NOT NULL REFERENCES question_history (id),
+  "order" INT,
+  min_value FLOAT,
+  max_value FLOAT,
+  step FLOAT,
+  target FLOAT,
+  tolerance FLOAT,
+  falloff FLOAT,
+  mark INT,
   created_at TIMESTAMPTZ NOT NULL,
   updated_at TIMESTAMPTZ NOT NULL,
   deleted_at TIMESTAMPTZ
@@ -237,6 +361,11 @@ CREATE TABLE IF NOT EXISTS live_quiz_session (
   quiz_id UUID NOT NULL REFERENCES quiz_history (id),
   status TEXT NOT NULL,
   exempted_question_ids TEXT,
+  scoring_config TEXT,
+  mode TEXT NOT NULL DEFAULT 'LIVE',
+  opens_at TIMESTAMPTZ,
+  closes_at TIMESTAMPTZ,
+  config TEXT,
   created_at TIMESTAMPTZ NOT NULL,
   updated_at TIMESTAMPTZ NOT NULL,
   deleted_at TIMESTAMPTZ
@@ -262,6 +391,38 @@ CREATE TABLE IF NOT EXISTS answer_response (
   question_id UUID NOT NULL REFERENCES question_history (id),
   answer TEXT,
   use_time INT,
+  marks INT,
+  comment TEXT,
+  graded_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  deleted_at TIMESTAMPTZ
+);
+CREATE TABLE IF NOT EXISTS live_quiz_schedule (
+  id UUID PRIMARY KEY NOT NULL REFERENCES live_quiz_session (id),
+  code TEXT NOT NULL,
+  opens_at TIMESTAMPTZ NOT NULL,
+  starts_at TIMESTAMPTZ NOT NULL,
+  config TEXT NOT NULL,
+  status TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+CREATE TABLE IF NOT EXISTS assignment_progress (
+  participant_id UUID PRIMARY KEY NOT NULL REFERENCES participant (id),
+  live_quiz_session_id UUID NOT NULL REFERENCES live_quiz_session (id),
+  progress TEXT NOT NULL,
+  current_question INT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+CREATE TABLE IF NOT EXISTS answer_response_grade (
+  id UUID PRIMARY KEY NOT NULL,
+  answer_response_id UUID NOT NULL REFERENCES answer_response (id),
+  grader_id UUID NOT NULL REFERENCES "user" (id),
+  previous_marks INT,
+  marks INT,
+  comment TEXT,
   created_at TIMESTAMPTZ NOT NULL,
   updated_at TIMESTAMPTZ NOT NULL,
   deleted_at TIMESTAMPTZ
@@ -12,6 +12,15 @@ func LiveRoutes(r *gin.RouterGroup, h *l.Handler) {
 	liveR.GET("/mod", middleware.LiveOptionalAuthentication, h.UpdateModerator)
 	liveR.GET("/end", middleware.UserRequiredAuthentication, h.EndLiveQuizSession)
 	liveR.GET("/check", h.CheckLiveQuizSessionAvailability)
-	liveR.GET("/join", h.JoinLiveQuizSession)
+	liveR.GET("/join", middleware.LiveOptionalAuthentication, h.JoinLiveQuizSession)
+	liveR.POST("/requests", middleware.LiveOptionalAuthentication, h.CreateJoinRequest)
+	liveR.GET("/requests", middleware.UserRequiredAuthentication, h.GetJoinRequests)
+	liveR.GET("/requests/:rid", h.GetJoinRequest)
 	liveR.GET("/interrupt", h.InterruptCountdown)
+
+	r.POST("assignments", middleware.UserRequiredAuthentication, h.CreateAssignment)
+	assignmentR := r.Group("/assignments/:id")
+	assignmentR.POST("/join", middleware.LiveOptionalAuthentication, h.JoinAssignment)
+	assignmentR.GET("/participants/:pid/question", h.GetAssignmentQuestion)
+	assignmentR.POST("/participants/:pid/answer", h.AnswerAssignmentQuestion)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"math"
+	"slices"
 	"strconv"
 	"time"
 
@@ -13,19 +15,22 @@ import (
 )
 
 type Client struct {
-	Conn              *websocket.Conn
-	Message           chan *Message
-	ID                uuid.UUID  `json:"id"`
-	UserID            *uuid.UUID `json:"uid"`
-	DisplayName       string     `json:"display_name"`
-	DisplayEmoji      string     `json:"display_emoji"`
-	DisplayColor      string     `json:"display_color"`
-	IsHost            bool       `json:"isHost"`
-	LiveQuizSessionID uuid.UUID  `json:"lqsId"`
-	Status            string     `json:"status"`
+	Conn              *websocket.Conn `json:"-"`
+	Message           chan *Message   `json:"-"`
+	ID                uuid.UUID       `json:"id"`
+	UserID            *uuid.UUID      `json:"uid"`
+	DisplayName       string          `json:"display_name"`
+	DisplayEmoji      string          `json:"display_emoji"`
+	DisplayColor      string          `json:"display_color"`
+	IsHost            bool            `json:"isHost"`
+	IsSpectator       bool            `json:"isSpectator"`
+	LiveQuizSessionID uuid.UUID       `json:"lqsId"`
+	Code              string          `json:"code"`
+	Status            string          `json:"status"`
 }
 
 type Message struct {
+	Seq               int64      `json:"seq"`
 	Content           Content    `json:"content"`
 	LiveQuizSessionID uuid.UUID  `json:"live_quiz_session_id"`
 	ClientID          uuid.UUID  `json:"client_id"`
@@ -52,18 +57,20 @@ func (c *Client) writeMessage() {
 	}
 }
 
+// send hands m to the writer of c without blocking the session it belongs to.
+func (c *Client) send(m *Message) {
+	select {
+	case c.Message <- m:
+	default:
+		log.Printf("Dropping message %v for slow client %v", m.Content.Type, c.ID)
+	}
+}
+
 func (c *Client) readMessage(h *Handler) {
 	defer func() {
 		log.Println("Closing connection")
-		if !c.IsHost {
-			p, err := h.Service.GetParticipantByID(context.Background(), c.ID)
-			if err != nil {
-				log.Printf("Error occured at defer readMessage: %v", err)
-				h.hub.Unregister <- c
-				c.Conn.Close()
-			}
-			p.Status = util.Left
-			if _, err = h.Service.UpdateParticipant(context.Background(), p); err != nil {
+		if !c.IsHost && !c.IsSpectator {
+			if err := h.Service.UpdateParticipantStatus(context.Background(), c.ID, util.Left); err != nil {
 				log.Printf("Error occured at defer readMessage: %v", err)
 				h.hub.Unregister <- c
 				c.Conn.Close()
@@ -80,7 +87,7 @@ func (c *Client) readMessage(h *Handler) {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) || websocket.IsCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("Websocket error occured: %v from isHost:%v", err, c.IsHost)
 
-				if _, ok := h.hub.LiveQuizSessions[c.LiveQuizSessionID]; !ok {
+				if _, ok := h.hub.GetLiveQuizSession(c.LiveQuizSessionID); ok && !c.IsHost && !c.IsSpectator {
 					participants, err := h.Service.GetParticipantsByLiveQuizSessionID(context.Background(), c.LiveQuizSessionID)
 					if err != nil {
 						log.Printf("Error occured: %v", err)
@@ -88,14 +95,14 @@ func (c *Client) readMessage(h *Handler) {
 					}
 					pCount := len(participants)
 
-					mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+					mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 					if err != nil {
 						log.Printf("Error occured: %v", err)
 						return
 					}
 					mod.ParticipantCount = pCount - 1
 
-					err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod)
+					err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
 					if err != nil {
 						log.Printf("Error occured: %v", err)
 						return
@@ -112,28 +119,45 @@ func (c *Client) readMessage(h *Handler) {
 			break
 		}
 
-		if _, ok := h.hub.LiveQuizSessions[c.LiveQuizSessionID]; !ok {
+		if _, ok := h.hub.GetLiveQuizSession(c.LiveQuizSessionID); !ok {
 			log.Println("No such session exists")
 			return
 		}
 
+		// Spectators only follow the session and may just ask for the
+		// leaderboard.
+		if c.IsSpectator && mstr.Type != util.GetParticipants {
+			log.Printf("Ignoring %v from spectator %v", mstr.Type, c.ID)
+			continue
+		}
+		if slices.Contains(hostControls, mstr.Type) && !c.mayControl(h, mstr.Type) {
+			log.Printf("Rejected %v from %v: not allowed for its role", mstr.Type, c.ID)
+			continue
+		}
+
 		switch mstr.Type {
 		case util.JoinLQS, util.LeaveLQS:
 			c.Converse(h, mstr)
 		case util.KickParticipant:
 			c.KickParticipant(h, mstr.Payload)
+		case util.AssignRole, util.RevokeRole:
+			c.AssignRole(h, mstr)
 		case util.StartLQS:
-			c.StartLiveQuizSession(h)
+			c.drive(h, c.StartLiveQuizSession)
 		case util.NextQuestion:
-			c.NextQuestion(h)
+			if c.mayControl(h, mstr.Type) {
+				c.drive(h, c.NextQuestion)
+			} else {
+				c.NextOwnQuestion(h)
+			}
 		case util.DistQuestion:
-			c.DistributeQuestion(h)
+			c.drive(h, c.DistributeQuestion)
 		case util.DistMedia:
-			c.DistributeMedia(h)
+			c.drive(h, c.DistributeMedia)
 		case util.DistOptions:
-			c.DistributeOptions(h)
+			c.drive(h, c.DistributeOptions)
 		case util.RevealAnswer:
-			c.RevealAnswer(h)
+			c.drive(h, c.RevealAnswer)
 		case util.Conclude:
 			c.Conclude(h)
 		case util.ToggleLock:
@@ -144,12 +168,89 @@ func (c *Client) readMessage(h *Handler) {
 			c.SubmitAnswer(h, mstr.Payload)
 		case util.UnsubmitAnswer:
 			c.UnsubmitAnswer(h)
+		case util.PauseTimer, util.ResumeTimer, util.ExtendTimer:
+			c.ControlTimer(h, mstr)
+		case util.OverrideMarks:
+			c.OverrideMarks(h, mstr.Payload)
+		case util.VoidQuestion:
+			c.VoidQuestion(h, mstr.Payload)
+		case util.ApproveJoin, util.RejectJoin:
+			c.AnswerJoinRequest(h, mstr)
 		default:
 			c.BroadcastMessage(h, mstr)
 		}
 	}
 }
 
+// hostControls are the actions that drive or moderate a session. Only the host
+// and the roles covering them may take them. Moving on to the next question is
+// left out, as participants do that too when they go at their own pace.
+var hostControls = []string{
+	util.KickParticipant, util.AssignRole, util.RevokeRole, util.StartLQS,
+	util.DistQuestion, util.DistMedia, util.DistOptions, util.RevealAnswer,
+	util.Conclude, util.ToggleLock, util.PauseTimer, util.ResumeTimer,
+	util.ExtendTimer, util.OverrideMarks, util.VoidQuestion, util.ApproveJoin,
+	util.RejectJoin,
+}
+
+// delegatedControls are the host controls each role the host can hand out
+// covers. A co-host runs the questions and also moderates.
+var delegatedControls = map[string][]string{
+	util.CoHostRole: {
+		util.NextQuestion, util.DistQuestion, util.DistMedia, util.DistOptions,
+		util.RevealAnswer, util.KickParticipant, util.ApproveJoin, util.RejectJoin,
+	},
+	util.ModeratorRole: {util.KickParticipant, util.ApproveJoin, util.RejectJoin},
+}
+
+// mayControl tells whether c may take a host control. Roles are kept on the
+// session and dropped whenever the host hands one out or takes one back.
+func (c *Client) mayControl(h *Handler, action string) bool {
+	if c.IsHost {
+		return true
+	}
+	if c.IsSpectator || c.UserID == nil {
+		return false
+	}
+
+	lqs, ok := h.hub.GetLiveQuizSession(c.LiveQuizSessionID)
+	if !ok {
+		return false
+	}
+	roles, err := lqs.getRoles(func() (map[string]string, error) {
+		mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
+		if err != nil {
+			return nil, err
+		}
+		return mod.Roles, nil
+	})
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return false
+	}
+	return rolesTrust(roles, c.UserID, action)
+}
+
+// drive runs a chain of phases in the background so that the connection keeps
+// reading, e.g. timer controls, while the countdowns run. A session only ever
+// has one chain running, and drive tells whether it started this one.
+func (c *Client) drive(h *Handler, phase func(h *Handler)) bool {
+	lqs, ok := h.hub.GetLiveQuizSession(c.LiveQuizSessionID)
+	if !ok {
+		return false
+	}
+	if !lqs.phase.TryLock() {
+		log.Printf("Ignoring phase change for %v: another one is in progress", lqs.ID)
+		return false
+	}
+
+	go func() {
+		defer lqs.phase.Unlock()
+		phase(h)
+	}()
+	return true
+}
+
 func (c *Client) KickParticipant(h *Handler, payload any) {
 	pid := payload.(map[string]any)["id"].(string)
 	kickedID, err := uuid.Parse(pid)
@@ -165,13 +266,15 @@ func (c *Client) KickParticipant(h *Handler, payload any) {
 	}
 	pCount := len(participants)
 
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 	mod.ParticipantCount = pCount
-	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod)
+	// Kicked participants cannot come back with their rejoin token.
+	mod.Kicked = append(mod.Kicked, kickedID.String())
+	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
@@ -189,12 +292,7 @@ func (c *Client) KickParticipant(h *Handler, payload any) {
 }
 
 func (c *Client) ToggleLiveQuizSessionLock(h *Handler) {
-	var code string
-	for _, s := range h.hub.LiveQuizSessions {
-		if s.ID == c.LiveQuizSessionID {
-			code = s.Code
-		}
-	}
+	code := c.Code
 
 	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), code)
 	if err != nil {
@@ -225,13 +323,13 @@ func (c *Client) GetParticipants(h *Handler) {
 	var err error
 	var p []Participant
 
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 
-	if mod.Status == util.Idle {
+	if mod.Status == util.Idle || mod.Status == util.Scheduled {
 		p, err = h.Service.GetParticipantsByLiveQuizSessionID(context.Background(), c.LiveQuizSessionID)
 		if err != nil {
 			log.Printf("Error occured: %v", err)
@@ -245,7 +343,7 @@ func (c *Client) GetParticipants(h *Handler) {
 		}
 	}
 
-	if !c.IsHost && (((mod.Status == util.Questioning || mod.Status == util.Answering) && !mod.Config.LeaderboardConfig.DuringQuestions) || (mod.Status == util.RevealingAnswer && !mod.Config.LeaderboardConfig.AfterQuestions)) {
+	if !c.IsHost && !mod.showsLeaderboard() {
 		p = []Participant{}
 	}
 
@@ -261,14 +359,14 @@ func (c *Client) GetParticipants(h *Handler) {
 }
 
 func (c *Client) StartLiveQuizSession(h *Handler) {
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 	mod.CurrentQuestion = 1
 	mod.Status = util.Starting
-	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod)
+	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
@@ -288,30 +386,53 @@ func (c *Client) StartLiveQuizSession(h *Handler) {
 	go c.Countdown(h, 3, c.LiveQuizSessionID, done)
 	<-done
 
+	if mod.Config.ShuffleConfig.PerParticipant {
+		c.DistributeOwnQuestions(h)
+		return
+	}
 	c.DistributeQuestion(h)
 }
 
 func (c *Client) DistributeQuestion(h *Handler) {
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 	mod.Status = util.Questioning
-	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod)
+	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 
-	h.hub.Broadcast <- &Message{
-		Content: Content{
-			Type:    util.DistQuestion,
-			Payload: mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1],
-		},
-		LiveQuizSessionID: c.LiveQuizSessionID,
-		ClientID:          c.ID,
-		UserID:            c.UserID,
+	if mod.Config.ShuffleConfig.Option {
+		members, err := h.hub.GetMembers(c.LiveQuizSessionID)
+		if err != nil {
+			log.Printf("Error occured: %v", err)
+			return
+		}
+		for _, m := range members {
+			h.hub.Inject <- &Message{
+				Content: Content{
+					Type:    util.DistQuestion,
+					Payload: mod.questionFor(m.ID, m.IsHost),
+				},
+				LiveQuizSessionID: c.LiveQuizSessionID,
+				ClientID:          m.ID,
+				UserID:            c.UserID,
+			}
+		}
+	} else {
+		h.hub.Broadcast <- &Message{
+			Content: Content{
+				Type:    util.DistQuestion,
+				Payload: mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1],
+			},
+			LiveQuizSessionID: c.LiveQuizSessionID,
+			ClientID:          c.ID,
+			UserID:            c.UserID,
+		}
 	}
 
 	done := make(chan struct{})
@@ -327,13 +448,13 @@ func (c *Client) DistributeQuestion(h *Handler) {
 }
 
 func (c *Client) NextQuestion(h *Handler) {
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 	mod.CurrentQuestion += 1
-	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod)
+	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
@@ -343,13 +464,13 @@ func (c *Client) NextQuestion(h *Handler) {
 }
 
 func (c *Client) DistributeMedia(h *Handler) {
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 	mod.Status = util.Media
-	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod)
+	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
@@ -375,13 +496,13 @@ func (c *Client) DistributeMedia(h *Handler) {
 }
 
 func (c *Client) DistributeOptions(h *Handler) {
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 	mod.Status = util.Answering
-	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod)
+	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
@@ -407,34 +528,47 @@ func (c *Client) DistributeOptions(h *Handler) {
 }
 
 func (c *Client) RevealAnswer(h *Handler) {
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured @691: %v", err)
 		return
 	}
 
-	res, err := h.Service.GetResponses(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["id"].(string))
+	// Participants going through the quiz on their own are all revealed at
+	// once, question by question.
+	if mod.Config.ShuffleConfig.PerParticipant {
+		for i := range mod.Questions {
+			c.revealAnswer(h, mod, i)
+		}
+		return
+	}
+
+	c.revealAnswer(h, mod, mod.Orders[mod.CurrentQuestion-1]-1)
+}
+
+func (c *Client) revealAnswer(h *Handler, mod *Cache, idx int) {
+	res, err := h.Service.GetResponses(context.Background(), c.Code, mod.Questions[idx].(map[string]any)["id"].(string))
 	if err != nil {
 		log.Printf("Error occured @697: %v", err)
 		return
 	}
 
-	qid, ok := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["id"].(string)
+	qid, ok := mod.Questions[idx].(map[string]any)["id"].(string)
 	if !ok {
 		log.Printf("Error occured @703: %v", err)
 		return
 	}
-	qType, ok := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["type"].(string)
+	qType, ok := mod.Questions[idx].(map[string]any)["type"].(string)
 	if !ok {
 		log.Printf("Error occured @708: %v", err)
 		return
 	}
-	qHaveTimeFactor, ok := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["have_time_factor"].(bool)
+	qHaveTimeFactor, ok := mod.Questions[idx].(map[string]any)["have_time_factor"].(bool)
 	if !ok {
 		log.Printf("Error occured @713: %v", err)
 		return
 	}
-	qTimeFactor, ok := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["time_factor"].(float64)
+	qTimeFactor, ok := mod.Questions[idx].(map[string]any)["time_factor"].(float64)
 	if !ok {
 		log.Printf("Error occured @718: %v", err)
 		return
@@ -442,19 +576,19 @@ func (c *Client) RevealAnswer(h *Handler) {
 	if !qHaveTimeFactor {
 		qTimeFactor = 0
 	}
-	qTimeLimit, ok := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["time_limit"].(float64)
+	qTimeLimit, ok := mod.Questions[idx].(map[string]any)["time_limit"].(float64)
 	if !ok {
 		log.Printf("Error occured @723: %v", err)
 		return
 	}
-	qAns, ok := mod.Answers[mod.Orders[mod.CurrentQuestion-1]-1].([]any)
+	qAns, ok := mod.Answers[idx].([]any)
 	if !ok {
 		log.Printf("Error occured @792: Type assertion failed")
 		return
 	}
 
 	ansCounts := make(map[string]int)
-	if qType == util.Choice || qType == util.TrueFalse {
+	if qType == util.Choice || qType == util.TrueFalse || qType == util.Poll {
 		for _, a := range qAns {
 			ansCounts[a.(map[string]any)["id"].(string)] = 0
 		}
@@ -492,7 +626,7 @@ func (c *Client) RevealAnswer(h *Handler) {
 
 			var cAnsRes ChoiceAnswerResponse
 
-			cAnsRes, ansCounts, err = h.Service.CalculateAndSaveChoiceResponse(context.Background(), co, qAns, ansCounts, time, qTimeLimit, qTimeFactor, &Response{
+			cAnsRes, ansCounts, err = h.Service.CalculateAndSaveChoiceResponse(context.Background(), co, qAns, ansCounts, time, mod.scoring(mod.Questions[idx], qTimeLimit, qTimeFactor, pid), &Response{
 				ID:                uuid.New(),
 				LiveQuizSessionID: c.LiveQuizSessionID,
 				QuestionID:        questionID,
@@ -507,6 +641,54 @@ func (c *Client) RevealAnswer(h *Handler) {
 			rpl = append(rpl, AnswerPayload{
 				Answers:       cAnsRes,
 				ParticipantID: participantID,
+				TotalMarks:    *cAnsRes.Marks,
+			})
+		}
+	case util.Poll, util.WordCloud:
+		for _, r := range res {
+			time, ok := r.(map[string]any)["time"].(float64)
+			if !ok {
+				log.Printf("Error occured @734: Type assertion failed")
+				return
+			}
+			questionID, err := uuid.Parse(qid)
+			if err != nil {
+				log.Printf("Error occured @741: %v", err)
+				return
+			}
+			pid, ok := r.(map[string]any)["pid"].(string)
+			if !ok {
+				log.Printf("Error occured @747: %v", err)
+				return
+			}
+			participantID, err := uuid.Parse(pid)
+			if err != nil {
+				log.Printf("Error occured @752: %v", err)
+				return
+			}
+			response := &Response{
+				ID:                uuid.New(),
+				LiveQuizSessionID: c.LiveQuizSessionID,
+				QuestionID:        questionID,
+				ParticipantID:     participantID,
+				Type:              qType,
+			}
+
+			var ansRes any
+			if qType == util.Poll {
+				po, _ := r.(map[string]any)["options"].([]any)
+				ansRes, ansCounts, err = h.Service.SavePollResponse(context.Background(), po, qAns, ansCounts, time, response)
+			} else {
+				ansRes, ansCounts, err = h.Service.SaveWordCloudResponse(context.Background(), wordCloudContent(r.(map[string]any)["options"]), ansCounts, time, response)
+			}
+			if err != nil {
+				log.Printf("Error occured @792: %v", err)
+				return
+			}
+
+			rpl = append(rpl, AnswerPayload{
+				Answers:       ansRes,
+				ParticipantID: participantID,
 			})
 		}
 	case util.FillBlank:
@@ -537,7 +719,7 @@ func (c *Client) RevealAnswer(h *Handler) {
 				return
 			}
 
-			fbAnsRes, err := h.Service.CalculateAndSaveFillBlankResponse(context.Background(), to, qAns, time, qTimeLimit, qTimeFactor, &Response{
+			fbAnsRes, err := h.Service.CalculateAndSaveFillBlankResponse(context.Background(), to, qAns, time, mod.scoring(mod.Questions[idx], qTimeLimit, qTimeFactor, pid), &Response{
 				ID:                uuid.New(),
 				LiveQuizSessionID: c.LiveQuizSessionID,
 				QuestionID:        questionID,
@@ -552,6 +734,7 @@ func (c *Client) RevealAnswer(h *Handler) {
 			rpl = append(rpl, AnswerPayload{
 				Answers:       fbAnsRes,
 				ParticipantID: participantID,
+				TotalMarks:    *fbAnsRes.Marks,
 			})
 		}
 	case util.Paragraph:
@@ -582,7 +765,7 @@ func (c *Client) RevealAnswer(h *Handler) {
 				return
 			}
 
-			pAnsRes, err := h.Service.CalculateAndSaveParagraphResponse(context.Background(), answer, qAns, time, qTimeLimit, qTimeFactor, &Response{
+			pAnsRes, err := h.Service.CalculateAndSaveParagraphResponse(context.Background(), answer, qAns, time, mod.scoring(mod.Questions[idx], qTimeLimit, qTimeFactor, pid), &Response{
 				ID:                uuid.New(),
 				LiveQuizSessionID: c.LiveQuizSessionID,
 				QuestionID:        questionID,
@@ -594,18 +777,64 @@ func (c *Client) RevealAnswer(h *Handler) {
 				return
 			}
 
+			pMarks := 0
+			if r, ok := pAnsRes.(TextAnswerResponse); ok {
+				pMarks = *r.Marks
+			}
+
 			rpl = append(rpl, AnswerPayload{
 				Answers:       pAnsRes,
 				ParticipantID: participantID,
+				TotalMarks:    pMarks,
 			})
 		}
-	case util.Matching:
+	case util.Numeric:
 		for _, r := range res {
-			mo, ok := r.(map[string]any)["options"].([]any)
+			answer := numericContent(r.(map[string]any)["options"])
+			time, ok := r.(map[string]any)["time"].(float64)
 			if !ok {
-				log.Printf("Error occured @729: Type assertion failed")
+				log.Printf("Error occured @734: Type assertion failed")
+				return
+			}
+			questionID, err := uuid.Parse(qid)
+			if err != nil {
+				log.Printf("Error occured @741: %v", err)
+				return
+			}
+			pid, ok := r.(map[string]any)["pid"].(string)
+			if !ok {
+				log.Printf("Error occured @747: %v", err)
+				return
+			}
+			participantID, err := uuid.Parse(pid)
+			if err != nil {
+				log.Printf("Error occured @752: %v", err)
+				return
+			}
+
+			var nAnsRes NumericAnswerResponse
+
+			nAnsRes, ansCounts, err = h.Service.CalculateAndSaveNumericResponse(context.Background(), answer, qAns, ansCounts, time, mod.scoring(mod.Questions[idx], qTimeLimit, qTimeFactor, pid), &Response{
+				ID:                uuid.New(),
+				LiveQuizSessionID: c.LiveQuizSessionID,
+				QuestionID:        questionID,
+				ParticipantID:     participantID,
+				Type:              qType,
+			})
+			if err != nil {
+				log.Printf("Error occured @792: %v", err)
 				return
 			}
+
+			rpl = append(rpl, AnswerPayload{
+				Answers:       nAnsRes,
+				ParticipantID: participantID,
+				TotalMarks:    *nAnsRes.Marks,
+			})
+		}
+	case util.Slider:
+		for _, r := range res {
+			value := sliderContent(r.(map[string]any)["options"])
 			time, ok := r.(map[string]any)["time"].(float64)
 			if !ok {
 				log.Printf("Error occured @734: Type assertion failed")
@@ -627,7 +856,9 @@ func (c *Client) RevealAnswer(h *Handler) {
 				return
 			}
 
-			mAnsRes, err := h.Service.CalculateAndSaveMatchingResponse(context.Background(), mo, qAns, time, qTimeLimit, qTimeFactor, &Response{
+			var sAnsRes SliderAnswerResponse
+
+			sAnsRes, ansCounts, err = h.Service.CalculateAndSaveSliderResponse(context.Background(), value, qAns, ansCounts, time, mod.scoring(mod.Questions[idx], qTimeLimit, qTimeFactor, pid), &Response{
 				ID:                uuid.New(),
 				LiveQuizSessionID: c.LiveQuizSessionID,
 				QuestionID:        questionID,
@@ -640,17 +871,24 @@ func (c *Client) RevealAnswer(h *Handler) {
 			}
 
 			rpl = append(rpl, AnswerPayload{
-				Answers:       mAnsRes,
+				Answers:       sAnsRes,
 				ParticipantID: participantID,
+				TotalMarks:    *sAnsRes.Marks,
 			})
 		}
-	case util.Pool:
+	case util.Hotspot:
 		for _, r := range res {
+			click := hotspotContent(r.(map[string]any)["options"])
 			time, ok := r.(map[string]any)["time"].(float64)
 			if !ok {
 				log.Printf("Error occured @734: Type assertion failed")
 				return
 			}
+			questionID, err := uuid.Parse(qid)
+			if err != nil {
+				log.Printf("Error occured @741: %v", err)
+				return
+			}
 			pid, ok := r.(map[string]any)["pid"].(string)
 			if !ok {
 				log.Printf("Error occured @747: %v", err)
@@ -662,37 +900,163 @@ func (c *Client) RevealAnswer(h *Handler) {
 				return
 			}
 
-			options, ok := r.(map[string]any)["options"].(map[string]any)
+			var hAnsRes HotspotAnswerResponse
+
+			hAnsRes, ansCounts, err = h.Service.CalculateAndSaveHotspotResponse(context.Background(), click, qAns, ansCounts, time, mod.scoring(mod.Questions[idx], qTimeLimit, qTimeFactor, pid), &Response{
+				ID:                uuid.New(),
+				LiveQuizSessionID: c.LiveQuizSessionID,
+				QuestionID:        questionID,
+				ParticipantID:     participantID,
+				Type:              qType,
+			})
+			if err != nil {
+				log.Printf("Error occured @792: %v", err)
+				return
+			}
+
+			rpl = append(rpl, AnswerPayload{
+				Answers:       hAnsRes,
+				ParticipantID: participantID,
+				TotalMarks:    *hAnsRes.Marks,
+			})
+		}
+	case util.Ordering:
+		for _, r := range res {
+			options := orderingContent(r.(map[string]any)["options"])
+			time, ok := r.(map[string]any)["time"].(float64)
 			if !ok {
-				log.Printf("Error occured @1093: Type assertion failed")
+				log.Printf("Error occured @734: Type assertion failed")
+				return
+			}
+			questionID, err := uuid.Parse(qid)
+			if err != nil {
+				log.Printf("Error occured @741: %v", err)
+				return
+			}
+			pid, ok := r.(map[string]any)["pid"].(string)
+			if !ok {
+				log.Printf("Error occured @747: %v", err)
+				return
+			}
+			participantID, err := uuid.Parse(pid)
+			if err != nil {
+				log.Printf("Error occured @752: %v", err)
 				return
 			}
 
-			ansRes := make(map[string]PoolAnswer, 0)
-			var marksRes int
-			var timeRes int
+			oAnsRes, err := h.Service.CalculateAndSaveOrderingResponse(context.Background(), options, qAns, time, mod.scoring(mod.Questions[idx], qTimeLimit, qTimeFactor, pid), &Response{
+				ID:                uuid.New(),
+				LiveQuizSessionID: c.LiveQuizSessionID,
+				QuestionID:        questionID,
+				ParticipantID:     participantID,
+				Type:              qType,
+			})
+			if err != nil {
+				log.Printf("Error occured @792: %v", err)
+				return
+			}
 
-			for i, o := range options {
-				I, err := strconv.Atoi(i)
-				if err != nil {
-					log.Printf("Error occured @3: %v", err)
-					return
-				}
-				sqType, ok := o.(map[string]any)["type"].(string)
-				if !ok {
-					log.Printf("Error occured @1109: Type assertion failed")
-					return
-				}
-				sqID, ok := o.(map[string]any)["qid"].(string)
-				if !ok {
-					log.Printf("Error occured @1114: Type assertion failed")
-					return
-				}
-				subqID, err := uuid.Parse(sqID)
-				if err != nil {
-					log.Printf("Error occured @1114: %v", err)
-					return
-				}
+			rpl = append(rpl, AnswerPayload{
+				Answers:       oAnsRes,
+				ParticipantID: participantID,
+				TotalMarks:    *oAnsRes.Marks,
+			})
+		}
+	case util.Matching:
+		for _, r := range res {
+			mo, ok := r.(map[string]any)["options"].([]any)
+			if !ok {
+				log.Printf("Error occured @729: Type assertion failed")
+				return
+			}
+			time, ok := r.(map[string]any)["time"].(float64)
+			if !ok {
+				log.Printf("Error occured @734: Type assertion failed")
+				return
+			}
+			questionID, err := uuid.Parse(qid)
+			if err != nil {
+				log.Printf("Error occured @741: %v", err)
+				return
+			}
+			pid, ok := r.(map[string]any)["pid"].(string)
+			if !ok {
+				log.Printf("Error occured @747: %v", err)
+				return
+			}
+			participantID, err := uuid.Parse(pid)
+			if err != nil {
+				log.Printf("Error occured @752: %v", err)
+				return
+			}
+
+			mAnsRes, err := h.Service.CalculateAndSaveMatchingResponse(context.Background(), mo, qAns, time, mod.scoring(mod.Questions[idx], qTimeLimit, qTimeFactor, pid), &Response{
+				ID:                uuid.New(),
+				LiveQuizSessionID: c.LiveQuizSessionID,
+				QuestionID:        questionID,
+				ParticipantID:     participantID,
+				Type:              qType,
+			})
+			if err != nil {
+				log.Printf("Error occured @792: %v", err)
+				return
+			}
+
+			rpl = append(rpl, AnswerPayload{
+				Answers:       mAnsRes,
+				ParticipantID: participantID,
+				TotalMarks:    *mAnsRes.Marks,
+			})
+		}
+	case util.Pool:
+		for _, r := range res {
+			time, ok := r.(map[string]any)["time"].(float64)
+			if !ok {
+				log.Printf("Error occured @734: Type assertion failed")
+				return
+			}
+			pid, ok := r.(map[string]any)["pid"].(string)
+			if !ok {
+				log.Printf("Error occured @747: %v", err)
+				return
+			}
+			participantID, err := uuid.Parse(pid)
+			if err != nil {
+				log.Printf("Error occured @752: %v", err)
+				return
+			}
+
+			options, ok := r.(map[string]any)["options"].(map[string]any)
+			if !ok {
+				log.Printf("Error occured @1093: Type assertion failed")
+				return
+			}
+
+			ansRes := make(map[string]PoolAnswer, 0)
+			var marksRes int
+			var timeRes int
+
+			for i, o := range options {
+				I, err := strconv.Atoi(i)
+				if err != nil {
+					log.Printf("Error occured @3: %v", err)
+					return
+				}
+				sqType, ok := o.(map[string]any)["type"].(string)
+				if !ok {
+					log.Printf("Error occured @1109: Type assertion failed")
+					return
+				}
+				sqID, ok := o.(map[string]any)["qid"].(string)
+				if !ok {
+					log.Printf("Error occured @1114: Type assertion failed")
+					return
+				}
+				subqID, err := uuid.Parse(sqID)
+				if err != nil {
+					log.Printf("Error occured @1114: %v", err)
+					return
+				}
 
 				ac := make(map[string]int)
 				ans, ok := qAns[I].([]any)
@@ -700,7 +1064,7 @@ func (c *Client) RevealAnswer(h *Handler) {
 					log.Printf("Error occured @1114: Type assertion failed")
 					return
 				}
-				if sqType == util.Choice || sqType == util.TrueFalse {
+				if sqType == util.Choice || sqType == util.TrueFalse || sqType == util.Poll {
 					for _, a := range ans {
 						ac[a.(map[string]any)["id"].(string)] = 0
 					}
@@ -716,7 +1080,7 @@ func (c *Client) RevealAnswer(h *Handler) {
 
 					var cAnsRes ChoiceAnswerResponse
 
-					cAnsRes, ac, err = h.Service.CalculateAndSaveChoiceResponse(context.Background(), sqContent, ans, ac, time, qTimeLimit, qTimeFactor, &Response{
+					cAnsRes, ac, err = h.Service.CalculateAndSaveChoiceResponse(context.Background(), sqContent, ans, ac, time, mod.scoring(subquestion(mod.Questions[idx], I), qTimeLimit, qTimeFactor, pid), &Response{
 						ID:                uuid.New(),
 						LiveQuizSessionID: c.LiveQuizSessionID,
 						QuestionID:        subqID,
@@ -736,6 +1100,41 @@ func (c *Client) RevealAnswer(h *Handler) {
 					marksRes += *cAnsRes.Marks
 					timeRes = cAnsRes.Time
 					mod.AnswerCounts[sqID] = ac
+				case util.Poll, util.WordCloud:
+					counts := mod.AnswerCounts[sqID]
+					if counts == nil {
+						counts = ac
+					}
+					response := &Response{
+						ID:                uuid.New(),
+						LiveQuizSessionID: c.LiveQuizSessionID,
+						QuestionID:        subqID,
+						ParticipantID:     participantID,
+						Type:              sqType,
+					}
+
+					var content any
+					if sqType == util.Poll {
+						sqContent, _ := o.(map[string]any)["content"].([]any)
+						var pAnsRes PollAnswerResponse
+						pAnsRes, counts, err = h.Service.SavePollResponse(context.Background(), sqContent, ans, counts, time, response)
+						content = pAnsRes.Answers
+					} else {
+						var wAnsRes WordCloudAnswerResponse
+						wAnsRes, counts, err = h.Service.SaveWordCloudResponse(context.Background(), wordCloudContent(o.(map[string]any)["content"]), counts, time, response)
+						content = wAnsRes.Answers
+					}
+					if err != nil {
+						log.Printf("Error occured @792: %v", err)
+						return
+					}
+
+					ansRes[i] = PoolAnswer{
+						ID:      sqID,
+						Type:    sqType,
+						Content: content,
+					}
+					mod.AnswerCounts[sqID] = counts
 				case util.FillBlank:
 					sqContent, ok := o.(map[string]any)["content"].([]any)
 					if !ok {
@@ -743,7 +1142,7 @@ func (c *Client) RevealAnswer(h *Handler) {
 						return
 					}
 
-					fbAnsRes, err := h.Service.CalculateAndSaveFillBlankResponse(context.Background(), sqContent, ans, time, qTimeLimit, qTimeFactor, &Response{
+					fbAnsRes, err := h.Service.CalculateAndSaveFillBlankResponse(context.Background(), sqContent, ans, time, mod.scoring(subquestion(mod.Questions[idx], I), qTimeLimit, qTimeFactor, pid), &Response{
 						ID:                uuid.New(),
 						LiveQuizSessionID: c.LiveQuizSessionID,
 						QuestionID:        subqID,
@@ -776,7 +1175,7 @@ func (c *Client) RevealAnswer(h *Handler) {
 					case nil:
 					}
 
-					pAnsRes, err := h.Service.CalculateAndSaveParagraphResponse(context.Background(), content, ans, time, qTimeLimit, qTimeFactor, &Response{
+					pAnsRes, err := h.Service.CalculateAndSaveParagraphResponse(context.Background(), content, ans, time, mod.scoring(subquestion(mod.Questions[idx], I), qTimeLimit, qTimeFactor, pid), &Response{
 						ID:                uuid.New(),
 						LiveQuizSessionID: c.LiveQuizSessionID,
 						QuestionID:        subqID,
@@ -807,6 +1206,95 @@ func (c *Client) RevealAnswer(h *Handler) {
 						timeRes = pAnsRes.Time
 					default:
 					}
+				case util.Numeric:
+					var nAnsRes NumericAnswerResponse
+
+					nAnsRes, ac, err = h.Service.CalculateAndSaveNumericResponse(context.Background(), numericContent(o.(map[string]any)["content"]), ans, ac, time, mod.scoring(subquestion(mod.Questions[idx], I), qTimeLimit, qTimeFactor, pid), &Response{
+						ID:                uuid.New(),
+						LiveQuizSessionID: c.LiveQuizSessionID,
+						QuestionID:        subqID,
+						ParticipantID:     participantID,
+						Type:              sqType,
+					})
+					if err != nil {
+						log.Printf("Error occured @792: %v", err)
+						return
+					}
+
+					ansRes[i] = PoolAnswer{
+						ID:      sqID,
+						Type:    sqType,
+						Content: nAnsRes,
+					}
+					marksRes += *nAnsRes.Marks
+					timeRes = nAnsRes.Time
+					mod.AnswerCounts[sqID] = ac
+				case util.Slider:
+					var sAnsRes SliderAnswerResponse
+
+					sAnsRes, ac, err = h.Service.CalculateAndSaveSliderResponse(context.Background(), sliderContent(o.(map[string]any)["content"]), ans, ac, time, mod.scoring(subquestion(mod.Questions[idx], I), qTimeLimit, qTimeFactor, pid), &Response{
+						ID:                uuid.New(),
+						LiveQuizSessionID: c.LiveQuizSessionID,
+						QuestionID:        subqID,
+						ParticipantID:     participantID,
+						Type:              sqType,
+					})
+					if err != nil {
+						log.Printf("Error occured @792: %v", err)
+						return
+					}
+
+					ansRes[i] = PoolAnswer{
+						ID:      sqID,
+						Type:    sqType,
+						Content: sAnsRes,
+					}
+					marksRes += *sAnsRes.Marks
+					timeRes = sAnsRes.Time
+					mod.AnswerCounts[sqID] = ac
+				case util.Hotspot:
+					var hAnsRes HotspotAnswerResponse
+
+					hAnsRes, ac, err = h.Service.CalculateAndSaveHotspotResponse(context.Background(), hotspotContent(o.(map[string]any)["content"]), ans, ac, time, mod.scoring(subquestion(mod.Questions[idx], I), qTimeLimit, qTimeFactor, pid), &Response{
+						ID:                uuid.New(),
+						LiveQuizSessionID: c.LiveQuizSessionID,
+						QuestionID:        subqID,
+						ParticipantID:     participantID,
+						Type:              sqType,
+					})
+					if err != nil {
+						log.Printf("Error occured @792: %v", err)
+						return
+					}
+
+					ansRes[i] = PoolAnswer{
+						ID:      sqID,
+						Type:    sqType,
+						Content: hAnsRes,
+					}
+					marksRes += *hAnsRes.Marks
+					timeRes = hAnsRes.Time
+					mod.AnswerCounts[sqID] = ac
+				case util.Ordering:
+					oAnsRes, err := h.Service.CalculateAndSaveOrderingResponse(context.Background(), orderingContent(o.(map[string]any)["content"]), ans, time, mod.scoring(subquestion(mod.Questions[idx], I), qTimeLimit, qTimeFactor, pid), &Response{
+						ID:                uuid.New(),
+						LiveQuizSessionID: c.LiveQuizSessionID,
+						QuestionID:        subqID,
+						ParticipantID:     participantID,
+						Type:              sqType,
+					})
+					if err != nil {
+						log.Printf("Error occured @792: %v", err)
+						return
+					}
+
+					ansRes[i] = PoolAnswer{
+						ID:      sqID,
+						Type:    sqType,
+						Content: oAnsRes.Answers,
+					}
+					marksRes += *oAnsRes.Marks
+					timeRes = oAnsRes.Time
 				case util.Matching:
 					sqContent, ok := o.(map[string]any)["content"].([]any)
 					if !ok {
@@ -814,7 +1302,7 @@ func (c *Client) RevealAnswer(h *Handler) {
 						return
 					}
 
-					mAnsRes, err := h.Service.CalculateAndSaveMatchingResponse(context.Background(), sqContent, ans, time, qTimeLimit, qTimeFactor, &Response{
+					mAnsRes, err := h.Service.CalculateAndSaveMatchingResponse(context.Background(), sqContent, ans, time, mod.scoring(subquestion(mod.Questions[idx], I), qTimeLimit, qTimeFactor, pid), &Response{
 						ID:                uuid.New(),
 						LiveQuizSessionID: c.LiveQuizSessionID,
 						QuestionID:        subqID,
@@ -843,10 +1331,23 @@ func (c *Client) RevealAnswer(h *Handler) {
 					Time:    timeRes,
 				},
 				ParticipantID: participantID,
+				TotalMarks:    marksRes,
 			})
 		}
 	}
 
+	// A streak only carries on for participants who scored on this question.
+	// Ungraded and voided questions do not break it.
+	if util.IsGraded(qType) && !mod.isExempted(qid) {
+		streaks := make(map[string]int)
+		for _, r := range rpl {
+			if r.TotalMarks > 0 {
+				streaks[r.ParticipantID.String()] = mod.Streaks[r.ParticipantID.String()] + 1
+			}
+		}
+		mod.Streaks = streaks
+	}
+
 	ps, err := h.Service.GetParticipantsByLiveQuizSessionID(context.Background(), c.LiveQuizSessionID)
 	if err != nil {
 		log.Printf("Error occured @818: %v", err)
@@ -896,17 +1397,16 @@ func (c *Client) RevealAnswer(h *Handler) {
 	mod.Status = util.RevealingAnswer
 	mod.AnswerCounts[qid] = ansCounts
 
-	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod)
+	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
 	if err != nil {
 		log.Printf("Error occured @699: %v", err)
 		return
 	}
 
-	var hostPID uuid.UUID
-	for _, cl := range h.hub.LiveQuizSessions[c.LiveQuizSessionID].Clients {
-		if cl.IsHost {
-			hostPID = cl.ID
-		}
+	members, err := h.hub.GetMembers(c.LiveQuizSessionID)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
 	}
 
 	correctAns, err := h.Service.GetAnswersResponseForHost(context.Background(), qid, qType, qAns, mod.AnswerCounts)
@@ -915,25 +1415,155 @@ func (c *Client) RevealAnswer(h *Handler) {
 		return
 	}
 
-	h.hub.Inject <- &Message{
+	// Spectators show the answer distribution along with the host, and those
+	// the host handed a role see it too.
+	for _, m := range members {
+		if !m.IsHost && !m.IsSpectator && !mod.assists(m.UserID) {
+			continue
+		}
+		h.hub.Inject <- &Message{
+			Content: Content{
+				Type:    util.RevealAnswer,
+				Payload: correctAns,
+			},
+			LiveQuizSessionID: c.LiveQuizSessionID,
+			ClientID:          m.ID,
+			UserID:            c.UserID,
+		}
+	}
+}
+
+// DistributeOwnQuestions opens the quiz in per-participant mode, where every
+// participant moves through their own questions at their own pace.
+func (c *Client) DistributeOwnQuestions(h *Handler) {
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	mod.Status = util.Answering
+	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	members, err := h.hub.GetMembers(c.LiveQuizSessionID)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	for _, m := range members {
+		if !m.IsHost && !m.IsSpectator {
+			c.sendOwnQuestion(h, mod, m.ID)
+		}
+	}
+}
+
+// NextOwnQuestion moves a participant on to their next question in
+// per-participant mode.
+func (c *Client) NextOwnQuestion(h *Handler) {
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if !mod.Config.ShuffleConfig.PerParticipant || mod.Status != util.Answering {
+		return
+	}
+
+	p, err := h.Service.GetProgress(context.Background(), c.Code, c.ID.String())
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if p == nil || p.isDone() {
+		return
+	}
+
+	p.CurrentQuestion += 1
+	p.StartedAt = time.Now()
+	if err := h.Service.UpdateProgress(context.Background(), c.Code, c.ID.String(), p); err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	c.sendOwnQuestion(h, mod, c.ID)
+}
+
+// sendOwnQuestion sends a participant the question they are on, drawing their
+// questions first if they have not got any yet.
+func (c *Client) sendOwnQuestion(h *Handler, mod *Cache, pid uuid.UUID) {
+	p, err := h.Service.GetProgress(context.Background(), c.Code, pid.String())
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if p == nil {
+		p = mod.newProgress()
+		if err := h.Service.UpdateProgress(context.Background(), c.Code, pid.String(), p); err != nil {
+			log.Printf("Error occured: %v", err)
+			return
+		}
+	}
+
+	if !p.isDone() {
+		h.hub.Inject <- &Message{
+			Content: Content{
+				Type:    util.DistQuestion,
+				Payload: mod.ownQuestion(p, pid),
+			},
+			LiveQuizSessionID: c.LiveQuizSessionID,
+			ClientID:          pid,
+			UserID:            c.UserID,
+		}
+	}
+
+	h.hub.Converse <- &Message{
 		Content: Content{
-			Type:    util.RevealAnswer,
-			Payload: correctAns,
+			Type: util.UpdateProgress,
+			Payload: ProgressPayload{
+				ParticipantID:   pid,
+				CurrentQuestion: p.CurrentQuestion,
+				QuestionCount:   len(p.Orders),
+			},
 		},
 		LiveQuizSessionID: c.LiveQuizSessionID,
-		ClientID:          hostPID,
+		ClientID:          pid,
 		UserID:            c.UserID,
 	}
 }
 
+// currentQuestion returns the index in mod.Questions of the question the client
+// is on, or -1 if there is none. In per-participant mode it is taken from the
+// client's own progress, which is returned as well.
+func (c *Client) currentQuestion(h *Handler, mod *Cache) (int, *Progress, error) {
+	if !mod.Config.ShuffleConfig.PerParticipant {
+		if mod.CurrentQuestion < 1 {
+			return -1, nil, nil
+		}
+		return mod.Orders[mod.CurrentQuestion-1] - 1, nil, nil
+	}
+
+	p, err := h.Service.GetProgress(context.Background(), c.Code, c.ID.String())
+	if err != nil {
+		return -1, nil, err
+	}
+	if p == nil || p.isDone() {
+		return -1, p, nil
+	}
+
+	return p.Orders[p.CurrentQuestion-1] - 1, p, nil
+}
+
 func (c *Client) Conclude(h *Handler) {
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 	mod.Status = util.Concluding
-	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code, mod)
+	err = h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
@@ -975,15 +1605,54 @@ func (c *Client) Conclude(h *Handler) {
 }
 
 func (c *Client) SubmitAnswer(h *Handler, payload any) {
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	if !mod.isAnswering() {
+		log.Printf("Rejected answer from %v: answering is closed", c.ID)
+		return
+	}
+
+	idx, progress, err := c.currentQuestion(h, mod)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
+	if idx < 0 {
+		log.Printf("Rejected answer from %v: no question to answer", c.ID)
+		return
+	}
 
-	code := h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code
+	code := c.Code
 	pid := c.ID.String()
-	qid := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["id"].(string)
+	question := mod.Questions[idx].(map[string]any)
+	qid := question["id"].(string)
+
+	// The time taken is measured here so that the time bonus does not depend
+	// on what the client claims. Like the rest of the scoring it is kept in
+	// tenths of a second.
+	answer, ok := payload.(map[string]any)
+	if !ok {
+		log.Printf("Error occured: Type assertion failed")
+		return
+	}
+	elapsed := mod.elapsed()
+	limit := mod.Deadline.Sub(mod.StartedAt)
+	if progress != nil {
+		timeLimit, _ := question["time_limit"].(float64)
+		elapsed = time.Since(progress.StartedAt)
+		limit = time.Duration(timeLimit) * time.Second
+		if question["type"] == util.Pool {
+			answer["options"] = poolAnswersByIndex(question, answer["options"])
+		}
+	}
+	if elapsed > limit {
+		elapsed = limit
+	}
+	answer["time"] = math.Floor(float64(elapsed.Milliseconds()) / 100)
 
 	exist, err := h.Service.DoesResponseExist(context.Background(), code, qid, pid)
 	if err != nil {
@@ -992,12 +1661,12 @@ func (c *Client) SubmitAnswer(h *Handler, payload any) {
 	}
 
 	if exist {
-		if err := h.Service.UpdateResponse(context.Background(), code, qid, pid, payload); err != nil {
+		if err := h.Service.UpdateResponse(context.Background(), code, qid, pid, answer); err != nil {
 			log.Printf("Error occured: %v", err)
 			return
 		}
 	} else {
-		if err := h.Service.CreateResponse(context.Background(), code, qid, pid, payload); err != nil {
+		if err := h.Service.CreateResponse(context.Background(), code, qid, pid, answer); err != nil {
 			log.Printf("Error occured at CreateResponse: %v", err)
 			return
 		}
@@ -1009,7 +1678,7 @@ func (c *Client) SubmitAnswer(h *Handler, payload any) {
 		return
 	}
 
-	if !mod.Config.ParticipantConfig.Reanswer && count == mod.ParticipantCount && mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["type"].(string) != util.Pool {
+	if progress == nil && !mod.Config.ParticipantConfig.Reanswer && count == mod.ParticipantCount && question["type"].(string) != util.Pool {
 		mod.Interrupted = true
 	}
 	mod.ResponseCount = count
@@ -1019,6 +1688,10 @@ func (c *Client) SubmitAnswer(h *Handler, payload any) {
 		return
 	}
 
+	if mod.Interrupted {
+		h.hub.Interrupt(c.LiveQuizSessionID)
+	}
+
 	h.hub.Converse <- &Message{
 		Content: Content{
 			Type:    util.SubmitAnswer,
@@ -1031,15 +1704,30 @@ func (c *Client) SubmitAnswer(h *Handler, payload any) {
 }
 
 func (c *Client) UnsubmitAnswer(h *Handler) {
-	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code)
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
 		return
 	}
 
-	code := h.hub.LiveQuizSessions[c.LiveQuizSessionID].Code
-	pid := c.ID.String()
-	qid := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["id"].(string)
+	if !mod.isAnswering() {
+		log.Printf("Rejected unsubmission from %v: answering is closed", c.ID)
+		return
+	}
+
+	idx, _, err := c.currentQuestion(h, mod)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if idx < 0 {
+		log.Printf("Rejected unsubmission from %v: no question to answer", c.ID)
+		return
+	}
+
+	code := c.Code
+	pid := c.ID.String()
+	qid := mod.Questions[idx].(map[string]any)["id"].(string)
 
 	if err := h.Service.FlushResponse(context.Background(), code, qid, pid); err != nil {
 		log.Printf("Error occured: %v", err)
@@ -1094,41 +1782,478 @@ func (c *Client) BroadcastMessage(h *Handler, ct Content) {
 	}
 }
 
+// Countdown sets an absolute deadline for the current phase, announces it and
+// returns when it passes or the countdown gets interrupted. While it runs the
+// host can pause, resume and extend it; every change is announced again.
 func (c *Client) Countdown(h *Handler, seconds int, lqsID uuid.UUID, cd chan<- struct{}) {
-	for i := float64(seconds) * 10; i > 0; i -= 1 {
-		if _, ok := h.hub.LiveQuizSessions[lqsID]; ok {
-			mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[lqsID].Code)
+	defer close(cd)
+
+	lqs, ok := h.hub.GetLiveQuizSession(lqsID)
+	if !ok {
+		return
+	}
+
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), lqs.Code)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	if mod.Interrupted {
+		mod.Interrupted = false
+		err = h.Service.UpdateLiveQuizSessionCache(context.Background(), lqs.Code, mod)
+		if err != nil {
+			log.Printf("Error occured: %v", err)
+			return
+		}
+	}
+
+	now := time.Now()
+	mod.Timer = Timer{
+		StartedAt: now,
+		Deadline:  now.Add(time.Duration(seconds) * time.Second),
+	}
+	err = h.Service.UpdateLiveQuizSessionTimer(context.Background(), lqs.Code, &mod.Timer)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	for drained := false; !drained; {
+		select {
+		case <-lqs.timer:
+		default:
+			drained = true
+		}
+	}
+
+	c.announceCountdown(h, lqs, mod)
+
+	timer := time.NewTimer(time.Until(mod.Deadline))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			return
+		case ct := <-lqs.timer:
+			if ct.Type == util.Intterrupt {
+				return
+			}
+
+			mod, err = h.Service.GetLiveQuizSessionCache(context.Background(), lqs.Code)
 			if err != nil {
 				log.Printf("Error occured: %v", err)
-				break
+				return
 			}
 
-			if mod.Interrupted {
-				mod.Interrupted = false
-				err = h.Service.UpdateLiveQuizSessionCache(context.Background(), h.hub.LiveQuizSessions[lqsID].Code, mod)
-				if err != nil {
-					log.Printf("Error occured: %v", err)
-					break
+			now := time.Now()
+			switch ct.Type {
+			case util.PauseTimer:
+				if mod.Paused {
+					continue
 				}
-				break
-			}
-			h.hub.Broadcast <- &Message{
-				Content: Content{
-					Type: util.Countdown,
-					Payload: CountDownPayload{
-						TimeLeft:        float64(i / 10),
-						CurrentQuestion: mod.CurrentQuestion,
-						Status:          mod.Status,
-					},
-				},
-				LiveQuizSessionID: lqsID,
-				ClientID:          h.hub.LiveQuizSessions[lqsID].ID,
-				UserID:            &h.hub.LiveQuizSessions[lqsID].HostID,
+				timer.Stop()
+				mod.Paused = true
+				mod.PausedAt = now
+			case util.ResumeTimer:
+				if !mod.Paused {
+					continue
+				}
+				// The time spent paused is pushed out of both ends so that
+				// answer times stay relative to the running clock.
+				pause := now.Sub(mod.PausedAt)
+				mod.StartedAt = mod.StartedAt.Add(pause)
+				mod.Deadline = mod.Deadline.Add(pause)
+				mod.Paused = false
+				timer.Reset(time.Until(mod.Deadline))
+			case util.ExtendTimer:
+				seconds, ok := timerSeconds(ct.Payload)
+				if !ok {
+					continue
+				}
+				mod.Deadline = mod.Deadline.Add(time.Duration(seconds) * time.Second)
+				if !mod.Paused {
+					timer.Stop()
+					timer.Reset(time.Until(mod.Deadline))
+				}
+			default:
+				continue
 			}
-			if i > 0 {
-				time.Sleep(time.Second / 10)
+
+			err = h.Service.UpdateLiveQuizSessionTimer(context.Background(), lqs.Code, &mod.Timer)
+			if err != nil {
+				log.Printf("Error occured: %v", err)
+				return
 			}
+
+			c.announceCountdown(h, lqs, mod)
+		}
+	}
+}
+
+func (c *Client) announceCountdown(h *Handler, lqs *LiveQuizSession, mod *Cache) {
+	h.hub.Broadcast <- &Message{
+		Content: Content{
+			Type: util.Countdown,
+			Payload: CountDownPayload{
+				TimeLeft:        mod.timeLeft().Seconds(),
+				Deadline:        mod.Deadline,
+				Paused:          mod.Paused,
+				CurrentQuestion: mod.CurrentQuestion,
+				Status:          mod.Status,
+			},
+		},
+		LiveQuizSessionID: lqs.ID,
+		ClientID:          lqs.ID,
+		UserID:            &lqs.HostID,
+	}
+}
+
+// ControlTimer lets the host pause, resume or extend the running countdown.
+func (c *Client) ControlTimer(h *Handler, ct Content) {
+	if !c.IsHost {
+		log.Printf("Rejected %v from %v: only the host can control the timer", ct.Type, c.ID)
+		return
+	}
+
+	seconds := 0
+	if ct.Type == util.ExtendTimer {
+		var ok bool
+		if seconds, ok = timerSeconds(ct.Payload); !ok {
+			log.Printf("Rejected %v from %v: invalid extension %v", ct.Type, c.ID, ct.Payload)
+			return
+		}
+	}
+
+	h.hub.ControlTimer(c.LiveQuizSessionID, ct.Type, seconds)
+}
+
+// timerSeconds reads the number of seconds to extend a countdown by, which is
+// one of the steps offered to the host.
+func timerSeconds(payload any) (int, bool) {
+	var seconds int
+	switch v := payload.(type) {
+	case float64:
+		seconds = int(v)
+	case int:
+		seconds = v
+	default:
+		return 0, false
+	}
+
+	switch seconds {
+	case 15, 30, 60:
+		return seconds, true
+	}
+	return 0, false
+}
+
+// OverrideMarks lets the host change the marks a participant got for a
+// question, e.g. to accept a creative answer. The participant gets their new
+// total and everyone who may see the leaderboard gets the new standings.
+func (c *Client) OverrideMarks(h *Handler, payload any) {
+	if !c.IsHost {
+		log.Printf("Rejected %v from %v: only the host can override marks", util.OverrideMarks, c.ID)
+		return
+	}
+
+	req, ok := marksOverride(payload)
+	if !ok {
+		log.Printf("Rejected %v from %v: invalid override %v", util.OverrideMarks, c.ID, payload)
+		return
+	}
+
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if mod.isExempted(req.QuestionID.String()) {
+		log.Printf("Rejected %v from %v: question %v is void", util.OverrideMarks, c.ID, req.QuestionID)
+		return
+	}
+
+	p, err := h.Service.OverrideMarks(context.Background(), c.LiveQuizSessionID, req)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	h.hub.Inject <- &Message{
+		Content: Content{
+			Type:    util.UpdateMarks,
+			Payload: p.Marks,
+		},
+		LiveQuizSessionID: c.LiveQuizSessionID,
+		ClientID:          p.ID,
+		UserID:            c.UserID,
+	}
+
+	c.pushStandings(h, mod)
+}
+
+// VoidQuestion lets the host void the current question, or a past one given
+// by ID. Nobody keeps the marks for it, neither those already given nor those
+// still to come.
+func (c *Client) VoidQuestion(h *Handler, payload any) {
+	if !c.IsHost {
+		log.Printf("Rejected %v from %v: only the host can void questions", util.VoidQuestion, c.ID)
+		return
+	}
+
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	var question any
+	qid := ""
+	if v, ok := payload.(map[string]any); ok {
+		qid, _ = v["question_id"].(string)
+	}
+	if qid == "" {
+		if mod.CurrentQuestion < 1 || mod.CurrentQuestion > len(mod.Orders) {
+			log.Printf("Rejected %v from %v: no question is being played", util.VoidQuestion, c.ID)
+			return
+		}
+		question = mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1]
+	} else {
+		var ok bool
+		if question, ok = mod.findQuestion(qid); !ok {
+			log.Printf("Rejected %v from %v: no such question %v", util.VoidQuestion, c.ID, qid)
+			return
+		}
+	}
+
+	ids := voidedIDs(question)
+	ps, err := h.Service.VoidQuestions(context.Background(), c.LiveQuizSessionID, ids)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	for _, id := range ids {
+		if !mod.isExempted(id) {
+			mod.Exempted = append(mod.Exempted, id)
+		}
+	}
+	if err := h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod); err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	h.hub.Broadcast <- &Message{
+		Content: Content{
+			Type:    util.VoidQuestion,
+			Payload: map[string]any{"question_id": questionID(question)},
+		},
+		LiveQuizSessionID: c.LiveQuizSessionID,
+		ClientID:          c.ID,
+		UserID:            c.UserID,
+	}
+	for _, p := range ps {
+		h.hub.Inject <- &Message{
+			Content: Content{
+				Type:    util.UpdateMarks,
+				Payload: p.Marks,
+			},
+			LiveQuizSessionID: c.LiveQuizSessionID,
+			ClientID:          p.ID,
+			UserID:            c.UserID,
+		}
+	}
+	c.pushStandings(h, mod)
+}
+
+// AnswerJoinRequest lets a participant in the waiting room in, or turns them
+// away. They find out by checking on their request.
+func (c *Client) AnswerJoinRequest(h *Handler, ct Content) {
+	payload, ok := ct.Payload.(map[string]any)
+	if !ok {
+		log.Printf("Rejected %v from %v: invalid payload %v", ct.Type, c.ID, ct.Payload)
+		return
+	}
+	rid, _ := payload["id"].(string)
+
+	jr, err := h.Service.GetJoinRequest(context.Background(), c.Code, rid)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if jr == nil {
+		log.Printf("Rejected %v from %v: no such join request %v", ct.Type, c.ID, rid)
+		return
+	}
+
+	jr.Status = util.JoinRejected
+	if ct.Type == util.ApproveJoin {
+		jr.Status = util.JoinApproved
+	}
+	if err := h.Service.UpdateJoinRequest(context.Background(), c.Code, jr); err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	h.hub.Inject <- &Message{
+		Content: Content{
+			Type:    util.JoinRequest,
+			Payload: jr,
+		},
+		LiveQuizSessionID: c.LiveQuizSessionID,
+		ClientID:          c.ID,
+		UserID:            c.UserID,
+	}
+}
+
+// pushStandings sends the leaderboard to the host, and to everyone else if
+// they may see it, after marks changed outside of a reveal.
+func (c *Client) pushStandings(h *Handler, mod *Cache) {
+	leaderboard, err := h.Service.GetLeaderboard(context.Background(), c.LiveQuizSessionID)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	if mod.showsLeaderboard() {
+		h.hub.Broadcast <- &Message{
+			Content: Content{
+				Type:    util.GetParticipants,
+				Payload: leaderboard,
+			},
+			LiveQuizSessionID: c.LiveQuizSessionID,
+			ClientID:          c.ID,
+			UserID:            c.UserID,
+		}
+	} else {
+		h.hub.Inject <- &Message{
+			Content: Content{
+				Type:    util.GetParticipants,
+				Payload: leaderboard,
+			},
+			LiveQuizSessionID: c.LiveQuizSessionID,
+			ClientID:          c.ID,
+			UserID:            c.UserID,
+		}
+	}
+
+	// Participants already have their final rank once the session concludes,
+	// so they get the new one.
+	if mod.Status != util.Concluding {
+		return
+	}
+	for i, lp := range leaderboard {
+		h.hub.Inject <- &Message{
+			Content: Content{
+				Type:    util.Conclude,
+				Payload: i + 1,
+			},
+			LiveQuizSessionID: lp.LiveQuizSessionID,
+			ClientID:          lp.ID,
+			UserID:            lp.UserID,
+		}
+	}
+}
+
+// marksOverride reads the participant, question and new marks the host sent.
+func marksOverride(payload any) (*MarksOverride, bool) {
+	v, ok := payload.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	pid, ok := v["participant_id"].(string)
+	if !ok {
+		return nil, false
+	}
+	qid, ok := v["question_id"].(string)
+	if !ok {
+		return nil, false
+	}
+	marks, ok := v["marks"].(float64)
+	if !ok {
+		return nil, false
+	}
+
+	participantID, err := uuid.Parse(pid)
+	if err != nil {
+		return nil, false
+	}
+	questionID, err := uuid.Parse(qid)
+	if err != nil {
+		return nil, false
+	}
+
+	return &MarksOverride{
+		ParticipantID: participantID,
+		QuestionID:    questionID,
+		Marks:         int(marks),
+	}, true
+}
+
+// AssignRole hands a role to the signed-in user behind a client of the
+// session, or takes it back. Everyone is told, so that the clients can show
+// the controls the user now has.
+func (c *Client) AssignRole(h *Handler, ct Content) {
+	payload, ok := ct.Payload.(map[string]any)
+	if !ok {
+		log.Printf("Rejected %v from %v: invalid payload %v", ct.Type, c.ID, ct.Payload)
+		return
+	}
+	cid, _ := payload["id"].(string)
+	role, _ := payload["role"].(string)
+	if ct.Type == util.AssignRole && role != util.CoHostRole && role != util.ModeratorRole {
+		log.Printf("Rejected %v from %v: unknown role %v", ct.Type, c.ID, role)
+		return
+	}
+
+	members, err := h.hub.GetMembers(c.LiveQuizSessionID)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	var target *Member
+	for i, m := range members {
+		if m.ID.String() == cid {
+			target = &members[i]
+			break
 		}
 	}
-	close(cd)
+	if target == nil || target.UserID == nil || target.IsHost || target.IsSpectator {
+		log.Printf("Rejected %v from %v: %v cannot be given a role", ct.Type, c.ID, cid)
+		return
+	}
+
+	mod, err := h.Service.GetLiveQuizSessionCache(context.Background(), c.Code)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if mod.Roles == nil {
+		mod.Roles = make(map[string]string)
+	}
+	if ct.Type == util.AssignRole {
+		mod.Roles[target.UserID.String()] = role
+	} else {
+		delete(mod.Roles, target.UserID.String())
+		role = ""
+	}
+	if err := h.Service.UpdateLiveQuizSessionCache(context.Background(), c.Code, mod); err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	h.hub.Broadcast <- &Message{
+		Content: Content{
+			Type: ct.Type,
+			Payload: map[string]any{
+				"id":   target.ID,
+				"uid":  target.UserID,
+				"role": role,
+			},
+		},
+		LiveQuizSessionID: c.LiveQuizSessionID,
+		ClientID:          c.ID,
+		UserID:            c.UserID,
+	}
 }
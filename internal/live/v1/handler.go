@@ -3,11 +3,15 @@ package v1
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log"
+	"math"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	q "github.com/Live-Quiz-Project/Backend/internal/quiz/v1"
 	"github.com/Live-Quiz-Project/Backend/internal/util"
@@ -30,6 +34,14 @@ func NewHandler(h *Hub, lServ Service, qServ q.Service) *Handler {
 	}
 }
 
+// rejoinTokenDuration is how long a participant can come back to a session
+// with their rejoin token, which outlasts the session cache.
+const rejoinTokenDuration = 5 * time.Hour
+
+// participantTokenHeader carries the token an assignment participant gets when
+// they join, which they answer with.
+const participantTokenHeader = "X-Participant-Token"
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -71,6 +83,31 @@ func (h *Handler) CreateLiveQuizSession(c *gin.Context) {
 		return
 	}
 
+	if req.Schedule != nil {
+		if !req.Schedule.StartsAt.After(time.Now()) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "A session can only be scheduled for a future time"})
+			return
+		}
+		if req.Schedule.opensAt().After(req.Schedule.StartsAt) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "The lobby must open before the session starts"})
+			return
+		}
+	}
+
+	if err := req.Config.ScoringConfig.validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.Config.JoinConfig.Passcode != "" {
+		hashed, err := util.HashPassword(req.Config.JoinConfig.Passcode)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		req.Config.JoinConfig.Passcode = hashed
+	}
+
 	quizTitle, err := h.quizService.GetQuizHistoryByID(c, *latestQuizID, hostID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -80,68 +117,36 @@ func (h *Handler) CreateLiveQuizSession(c *gin.Context) {
 	lqsID := uuid.New()
 
 	var code string
-	codes := make([]string, 0)
-	for _, s := range h.hub.LiveQuizSessions {
-		if s.QuizID == req.QuizID {
-			lqsID = s.ID
-		}
-		codes = append(codes, s.Code)
-	}
-
-	if _, ok := h.hub.LiveQuizSessions[lqsID]; !ok {
-		code = util.CodeGenerator(codes)
-		lqs, err := h.Service.CreateLiveQuizSession(c, *latestQuizID, lqsID, code, hostID)
+	existing, ok := h.hub.GetLiveQuizSessionByQuizID(*latestQuizID)
+	if !ok {
+		code = util.CodeGenerator(h.hub.GetCodes())
+		lqs, err := h.Service.CreateLiveQuizSession(c, *latestQuizID, lqsID, code, hostID, req.Config.ScoringConfig)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 
-		questions, err := h.quizService.GetQuestionsByQuizIDForLQS(c, *latestQuizID)
+		mod, err := h.newLiveQuizSessionCache(c, lqsID, *latestQuizID, hostID, quizTitle.Title, req.Config)
 		if err != nil {
 			log.Printf("Error occured: %v", err)
 			return
 		}
-
-		count := len(questions)
-		orders := make([]int, count)
-		if req.Config.ShuffleConfig.Question {
-			orders = util.ShuffleNumbers(count)
-		} else {
-			for i := 0; i < count; i++ {
-				orders[i] = i + 1
+		if req.Schedule != nil {
+			opensAt := req.Schedule.opensAt()
+			mod.OpensAt = &opensAt
+			mod.StartsAt = &req.Schedule.StartsAt
+			if opensAt.After(time.Now()) {
+				mod.Status = util.Scheduled
 			}
 		}
 
-		answers, err := h.quizService.GetAnswersByQuizIDForLQS(c, *latestQuizID)
+		err = h.Service.CreateLiveQuizSessionCache(context.Background(), code, mod)
 		if err != nil {
 			log.Printf("Error occured: %v", err)
 			return
 		}
 
-		err = h.Service.CreateLiveQuizSessionCache(context.Background(), code, &Cache{
-			LiveQuizSessionID: lqsID,
-			HostID:            hostID,
-			QuizTitle:         quizTitle.Title,
-			QuizID:            lqs.QuizID,
-			QuestionCount:     count,
-			CurrentQuestion:   0,
-			Questions:         questions,
-			Answers:           answers,
-			AnswerCounts:      make(map[string]map[string]int),
-			Status:            util.Idle,
-			Config:            req.Config,
-			Locked:            false,
-			Interrupted:       false,
-			Orders:            orders,
-			ResponseCount:     0,
-			ParticipantCount:  0,
-		})
-		if err != nil {
-			log.Printf("Error occured: %v", err)
-			return
-		}
-
-		h.hub.LiveQuizSessions[lqsID] = &LiveQuizSession{
+		err = h.hub.AddLiveQuizSession(&LiveQuizSession{
 			Session: Session{
 				ID:                  lqs.ID,
 				HostID:              hostID,
@@ -151,22 +156,79 @@ func (h *Handler) CreateLiveQuizSession(c *gin.Context) {
 			},
 			Code:    lqs.Code,
 			Clients: make(map[uuid.UUID]*Client),
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if req.Schedule != nil {
+			if err := h.Service.CreateLiveQuizSchedule(c, lqsID, code, req.Schedule.opensAt(), req.Schedule.StartsAt, req.Config); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
 		}
+
 		c.JSON(http.StatusOK, &CreateLiveQuizSessionResponse{
-			ID:     lqsID,
-			QuizID: req.QuizID,
-			Code:   code,
+			ID:       lqsID,
+			QuizID:   req.QuizID,
+			Code:     code,
+			OpensAt:  mod.OpensAt,
+			StartsAt: mod.StartsAt,
 		})
 		return
 	}
 
 	c.JSON(http.StatusOK, &CreateLiveQuizSessionResponse{
-		ID:     h.hub.LiveQuizSessions[lqsID].ID,
-		QuizID: h.hub.LiveQuizSessions[lqsID].QuizID,
-		Code:   h.hub.LiveQuizSessions[lqsID].Code,
+		ID:     existing.ID,
+		QuizID: existing.QuizID,
+		Code:   existing.Code,
 	})
 }
 
+// newLiveQuizSessionCache sets up the cache of a session that has yet to
+// start, with the lobby open.
+func (h *Handler) newLiveQuizSessionCache(ctx context.Context, lqsID uuid.UUID, quizID uuid.UUID, hostID uuid.UUID, title string, config Configurations) (*Cache, error) {
+	questions, err := h.quizService.GetQuestionsByQuizIDForLQS(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	count := len(questions)
+	orders := make([]int, count)
+	if config.ShuffleConfig.Question {
+		orders = util.ShuffleNumbers(count)
+	} else {
+		for i := 0; i < count; i++ {
+			orders[i] = i + 1
+		}
+	}
+
+	answers, err := h.quizService.GetAnswersByQuizIDForLQS(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{
+		LiveQuizSessionID: lqsID,
+		HostID:            hostID,
+		QuizTitle:         title,
+		QuizID:            quizID,
+		QuestionCount:     count,
+		CurrentQuestion:   0,
+		Questions:         questions,
+		Answers:           answers,
+		AnswerCounts:      make(map[string]map[string]int),
+		Status:            util.Idle,
+		Config:            config,
+		Locked:            false,
+		Interrupted:       false,
+		Orders:            orders,
+		ResponseCount:     0,
+		ParticipantCount:  0,
+	}, nil
+}
+
 func (h *Handler) GetLiveQuizSessions(c *gin.Context) {
 	lqs, err := h.Service.GetLiveQuizSessions(c, h.hub)
 	if err != nil {
@@ -201,43 +263,52 @@ func (h *Handler) EndLiveQuizSession(c *gin.Context) {
 	}
 
 	code := c.Param("code")
-	var lqsID uuid.UUID
-	for _, s := range h.hub.LiveQuizSessions {
-		if s.Code == code && s.HostID == userID {
-			lqsID = s.ID
-			break
-		}
-	}
-
-	if _, ok := h.hub.LiveQuizSessions[lqsID]; !ok {
+	lqs, ok := h.hub.GetLiveQuizSessionByCode(code)
+	if !ok {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "No such session exists"})
 		return
 	}
 
-	if userID != h.hub.LiveQuizSessions[lqsID].HostID {
+	if userID != lqs.HostID {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Only the host can end the session"})
 		return
 	}
 
-	for _, cl := range h.hub.LiveQuizSessions[lqsID].Clients {
+	members, err := h.hub.GetMembers(lqs.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	for _, m := range members {
 		h.hub.Inject <- &Message{
 			Content: Content{
 				Type:    util.EndLQS,
 				Payload: nil,
 			},
-			LiveQuizSessionID: lqsID,
-			ClientID:          cl.ID,
-			UserID:            cl.UserID,
+			LiveQuizSessionID: lqs.ID,
+			ClientID:          m.ID,
+			UserID:            m.UserID,
 		}
 	}
 
-	err = h.Service.FlushAllLiveQuizSessionRelatedCache(c, h.hub.LiveQuizSessions[lqsID].Code)
+	if _, err := h.Service.ClaimLiveQuizSchedule(c, lqs.ID, []string{util.SchedulePending, util.ScheduleOpen}, util.ScheduleCancelled); err != nil {
+		log.Printf("Error occured: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	err = h.Service.FlushAllLiveQuizSessionRelatedCache(c, lqs.Code)
 	if err != nil {
 		log.Printf("Error occured: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	delete(h.hub.LiveQuizSessions, lqsID)
+	if err := h.hub.RemoveLiveQuizSession(lqs.ID); err != nil {
+		log.Printf("Error occured: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Successfully ended the session"})
 }
@@ -251,14 +322,22 @@ func (h *Handler) CheckLiveQuizSessionAvailability(c *gin.Context) {
 		return
 	}
 
+	s, ok := h.hub.GetLiveQuizSessionByCode(code)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No such session exists"})
+		return
+	}
+
+	members, err := h.hub.GetMembers(s.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
 	count := 0
-	for _, s := range h.hub.LiveQuizSessions {
-		if s.Code == code {
-			for _, cl := range s.Clients {
-				if cl.Status == util.Joined {
-					count++
-				}
-			}
+	for _, m := range members {
+		if m.Status == util.Joined && !m.IsSpectator {
+			count++
 		}
 	}
 
@@ -267,45 +346,92 @@ func (h *Handler) CheckLiveQuizSessionAvailability(c *gin.Context) {
 		return
 	}
 
-	for _, s := range h.hub.LiveQuizSessions {
-		if s.Code == code {
-			c.JSON(http.StatusOK, &CheckLiveQuizSessionAvailabilityResponse{
-				ID:              s.ID,
-				QuizID:          s.QuizID,
-				Code:            s.Code,
-				QuizTitle:       mod.QuizTitle,
-				QuestionCount:   mod.QuestionCount,
-				CurrentQuestion: mod.CurrentQuestion,
-				Status:          mod.Status,
-			})
-			return
-		}
-	}
-
-	c.JSON(http.StatusBadRequest, gin.H{"error": "No such session exists"})
+	c.JSON(http.StatusOK, &CheckLiveQuizSessionAvailabilityResponse{
+		ID:              s.ID,
+		QuizID:          s.QuizID,
+		Code:            s.Code,
+		QuizTitle:       mod.QuizTitle,
+		QuestionCount:   mod.QuestionCount,
+		CurrentQuestion: mod.CurrentQuestion,
+		Status:          mod.Status,
+		OpensAt:         mod.OpensAt,
+		StartsAt:        mod.StartsAt,
+	})
 }
 
 func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 	var err error
 	code := c.Param("code")
 
-	uid := c.Query("uid")
-	var userID *uuid.UUID
-	userID = nil
-	if uid != "" {
-		parsedUID, err := uuid.Parse(uid)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+	userID := authenticatedUserID(c)
+
+	lqs, ok := h.hub.GetLiveQuizSessionByCode(code)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No such session exists"})
+		return
+	}
+	lqsID := lqs.ID
+	// A spectator, e.g. the projector screen of a classroom, only follows the
+	// session. It is never the host, even when signed in as the host.
+	isSpectator := c.Query("spectator") == "true"
+	isHostUser := userID != nil && lqs.HostID == *userID
+	isHost := isHostUser && !isSpectator
+
+	// Participants coming back to the session prove who they are with the
+	// rejoin token handed to them when they first joined.
+	participantID := uuid.New()
+	rejoining := false
+	if rejoin := c.Query("rejoin"); rejoin != "" && !isHostUser && !isSpectator {
+		claims, err := util.DecodeRejoinToken(rejoin, os.Getenv("REJOIN_TOKEN_SECRET"))
+		if err != nil || claims.LiveQuizSessionID != lqsID {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid rejoin token"})
 			return
 		}
-		userID = &parsedUID
+		participantID = claims.ParticipantID
+		rejoining = true
 	}
 
-	var isHost bool
-	for _, s := range h.hub.LiveQuizSessions {
-		if s.Code == code && userID != nil && s.HostID == *userID {
-			isHost = true
-			break
+	// Participants can only check in to a scheduled session until its lobby
+	// opens, while the host can come in any time. The join policy is enforced
+	// here, as nothing can be refused once the connection is upgraded, and
+	// nobody gets in when it cannot be read.
+	mod, err := h.Service.GetLiveQuizSessionCache(c, code)
+	if err != nil && err.Error() == "redis: nil" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No such session exists"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !isHostUser {
+		if mod.Status == util.Scheduled {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "The session has not opened yet"})
+			return
+		}
+		if mod.Locked && !isSpectator {
+			c.JSON(http.StatusForbidden, gin.H{"error": "The session is locked"})
+			return
+		}
+		if rejoining {
+			// Rejoining participants were let in once already, so only
+			// what may have changed since is checked again.
+			if slices.Contains(mod.Kicked, participantID.String()) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "You were removed from this session"})
+				return
+			}
+			if !h.checkJoinPolicy(c, mod.Config.JoinConfig, c.Query("passcode")) {
+				return
+			}
+		}
+		if !rejoining {
+			requestID, ok := h.admitParticipant(c, mod, code)
+			if !ok {
+				return
+			}
+			if requestID != nil {
+				participantID = *requestID
+			}
 		}
 	}
 
@@ -313,17 +439,14 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 	emoji := c.Query("emoji")
 	color := c.Query("color")
 
-	var lqsID uuid.UUID
-	for _, s := range h.hub.LiveQuizSessions {
-		if s.Code == code {
-			lqsID = s.ID
-			break
+	var lastSeq *int64
+	if seq := c.Query("seq"); seq != "" {
+		parsedSeq, err := strconv.ParseInt(seq, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sequence number"})
+			return
 		}
-	}
-
-	if _, ok := h.hub.LiveQuizSessions[lqsID]; !ok {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "No such session exists"})
-		return
+		lastSeq = &parsedSeq
 	}
 
 	conn, e := upgrader.Upgrade(c.Writer, c.Request, nil)
@@ -332,16 +455,6 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 		return
 	}
 
-	pid := c.Query("pid")
-	participantID := uuid.New()
-	if pid != "" {
-		participantID, err = uuid.Parse(pid)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid participant ID"})
-			return
-		}
-	}
-
 	p := &Participant{
 		ID:                participantID,
 		UserID:            userID,
@@ -354,23 +467,20 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 	}
 
 	var pCount int
-	if !isHost {
+	if !isHost && !isSpectator {
 		exists, eErr := h.Service.DoesParticipantExist(c, p.ID)
 		if eErr != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": eErr.Error()})
 			return
 		}
 		if exists {
-			p, err := h.Service.GetParticipantByID(c, p.ID)
+			existing, err := h.Service.GetParticipantByID(c, p.ID)
 			if err != nil {
 				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 				return
 			}
-			p.Name = uname
-			p.Emoji = emoji
-			p.Color = color
-			p.Status = util.Joined
-			if _, err = h.Service.UpdateParticipant(c, p); err != nil {
+			p.Marks = existing.Marks
+			if err = h.Service.UpdateParticipantProfile(c, p); err != nil {
 				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 				return
 			}
@@ -389,7 +499,7 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 		return
 	}
 	pCount = len(participants)
-	mod, err := h.Service.GetLiveQuizSessionCache(c, code)
+	mod, err = h.Service.GetLiveQuizSessionCache(c, code)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -409,13 +519,15 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 		DisplayEmoji:      p.Emoji,
 		DisplayColor:      p.Color,
 		IsHost:            isHost,
+		IsSpectator:       isSpectator,
 		LiveQuizSessionID: lqsID,
+		Code:              code,
 		Status:            util.Joined,
 	}
 	h.hub.Register <- cl
 
 	var answers any
-	if !isHost && mod.CurrentQuestion > 0 && (mod.Status == util.Answering || mod.Status == util.RevealingAnswer) {
+	if !isHost && !isSpectator && !mod.Config.ShuffleConfig.PerParticipant && mod.CurrentQuestion > 0 && (mod.Status == util.Answering || mod.Status == util.RevealingAnswer) {
 		res, err := h.Service.GetResponse(c, code, mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["id"].(string), p.ID.String())
 		if err != nil {
 			log.Printf("Error occured here @399: %v", err)
@@ -455,6 +567,12 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 			if !qHaveTimeFactor {
 				qTimeFactor = 0
 			}
+			// Once the answer has been revealed the streak already counts it.
+			question := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1]
+			scoring := mod.scoring(question, qTimeLimit, qTimeFactor, p.ID.String())
+			if mod.Status == util.RevealingAnswer && scoring.Streak > 0 {
+				scoring.Streak -= 1
+			}
 
 			switch qType {
 			case util.Choice, util.TrueFalse:
@@ -464,7 +582,25 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 					return
 				}
 
-				answers, err = h.Service.CalculateChoice(c, mod.Status, opt, qAns, time, qTimeLimit, qTimeFactor)
+				answers, err = h.Service.CalculateChoice(c, mod.Status, opt, qAns, time, scoring)
+				if err != nil {
+					log.Printf("Error occured @456: %v", err)
+					return
+				}
+			case util.Poll:
+				opt, ok := res.(map[string]any)["options"].([]any)
+				if !ok {
+					log.Printf("Error occured @456: Type assertion failed")
+					return
+				}
+
+				answers, err = h.Service.CalculatePoll(c, opt, qAns, time)
+				if err != nil {
+					log.Printf("Error occured @456: %v", err)
+					return
+				}
+			case util.WordCloud:
+				answers, err = h.Service.CalculateWordCloud(c, wordCloudContent(res.(map[string]any)["options"]), time)
 				if err != nil {
 					log.Printf("Error occured @456: %v", err)
 					return
@@ -476,7 +612,7 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 					return
 				}
 
-				answers, err = h.Service.CalculateFillBlank(c, mod.Status, opt, qAns, time, qTimeLimit, qTimeFactor)
+				answers, err = h.Service.CalculateFillBlank(c, mod.Status, opt, qAns, time, scoring)
 				if err != nil {
 					log.Printf("Error occured @456: %v", err)
 					return
@@ -488,7 +624,39 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 					return
 				}
 
-				answers, err = h.Service.CalculateParagraph(c, mod.Status, opt, qAns, time, qTimeLimit, qTimeFactor)
+				answers, err = h.Service.CalculateParagraph(c, mod.Status, opt, qAns, time, scoring)
+				if err != nil {
+					log.Printf("Error occured @456: %v", err)
+					return
+				}
+			case util.Numeric:
+				opt := numericContent(res.(map[string]any)["options"])
+
+				answers, err = h.Service.CalculateNumeric(c, mod.Status, opt, qAns, time, scoring)
+				if err != nil {
+					log.Printf("Error occured @456: %v", err)
+					return
+				}
+			case util.Slider:
+				opt := sliderContent(res.(map[string]any)["options"])
+
+				answers, err = h.Service.CalculateSlider(c, mod.Status, opt, qAns, time, scoring)
+				if err != nil {
+					log.Printf("Error occured @456: %v", err)
+					return
+				}
+			case util.Hotspot:
+				opt := hotspotContent(res.(map[string]any)["options"])
+
+				answers, err = h.Service.CalculateHotspot(c, mod.Status, opt, qAns, time, scoring)
+				if err != nil {
+					log.Printf("Error occured @456: %v", err)
+					return
+				}
+			case util.Ordering:
+				opt := orderingContent(res.(map[string]any)["options"])
+
+				answers, err = h.Service.CalculateOrdering(c, mod.Status, opt, qAns, time, scoring)
 				if err != nil {
 					log.Printf("Error occured @456: %v", err)
 					return
@@ -500,7 +668,7 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 					return
 				}
 
-				answers, err = h.Service.CalculateMatching(c, mod.Status, opt, qAns, time, qTimeLimit, qTimeFactor)
+				answers, err = h.Service.CalculateMatching(c, mod.Status, opt, qAns, time, scoring)
 				if err != nil {
 					log.Printf("Error occured @456: %v", err)
 					return
@@ -542,7 +710,12 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 						}
 						a := qAns[I].([]any)
 
-						r, err := h.Service.CalculateChoice(c, mod.Status, opt, a, time, qTimeLimit, qTimeFactor)
+						sqScoring := scoring
+						if sq, ok := subquestion(question, I).(map[string]any); ok {
+							sqScoring.SelectGrading, _ = sq["select_grading"].(string)
+						}
+
+						r, err := h.Service.CalculateChoice(c, mod.Status, opt, a, time, sqScoring)
 						if err != nil {
 							log.Printf("Error occured @4: %v", err)
 							return
@@ -555,6 +728,35 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 						}
 						marksRes += *r.Marks
 						timeRes = r.Time
+					case util.Poll:
+						opt, _ := o.(map[string]any)["content"].([]any)
+						a := qAns[I].([]any)
+
+						r, err := h.Service.CalculatePoll(c, opt, a, time)
+						if err != nil {
+							log.Printf("Error occured @4: %v", err)
+							return
+						}
+
+						ansRes[i] = PoolAnswer{
+							ID:      sqID,
+							Type:    sqType,
+							Content: r.Answers,
+						}
+						timeRes = r.Time
+					case util.WordCloud:
+						r, err := h.Service.CalculateWordCloud(c, wordCloudContent(o.(map[string]any)["content"]), time)
+						if err != nil {
+							log.Printf("Error occured @4: %v", err)
+							return
+						}
+
+						ansRes[i] = PoolAnswer{
+							ID:      sqID,
+							Type:    sqType,
+							Content: r.Answers,
+						}
+						timeRes = r.Time
 					case util.FillBlank:
 						opt, ok := o.(map[string]any)["content"].([]any)
 						if !ok {
@@ -563,7 +765,7 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 						}
 						a := qAns[I].([]any)
 
-						r, err := h.Service.CalculateFillBlank(c, mod.Status, opt, a, time, qTimeLimit, qTimeFactor)
+						r, err := h.Service.CalculateFillBlank(c, mod.Status, opt, a, time, scoring)
 						if err != nil {
 							log.Printf("Error occured @6: %v", err)
 							return
@@ -591,7 +793,7 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 						case nil:
 						}
 
-						r, err := h.Service.CalculateParagraph(c, mod.Status, content, a, time, qTimeLimit, qTimeFactor)
+						r, err := h.Service.CalculateParagraph(c, mod.Status, content, a, time, scoring)
 						if err != nil {
 							log.Printf("Error occured @8: %v", err)
 							return
@@ -624,60 +826,137 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 							timeRes = r.Time
 						default:
 						}
-					case util.Matching:
-						opt, ok := o.(map[string]any)["content"].([]any)
-						if !ok {
-							log.Printf("Error occured @9: Type assertion failed")
+					case util.Numeric:
+						a := qAns[I].([]any)
+
+						r, err := h.Service.CalculateNumeric(c, mod.Status, numericContent(o.(map[string]any)["content"]), a, time, scoring)
+						if err != nil {
+							log.Printf("Error occured @8: %v", err)
 							return
 						}
+
+						ansRes[i] = PoolAnswer{
+							ID:      sqID,
+							Type:    sqType,
+							Content: r,
+						}
+						if r.Marks != nil {
+							marksRes += *r.Marks
+						}
+						timeRes = r.Time
+					case util.Slider:
 						a := qAns[I].([]any)
 
-						r, err := h.Service.CalculateMatching(c, mod.Status, opt, a, time, qTimeLimit, qTimeFactor)
+						r, err := h.Service.CalculateSlider(c, mod.Status, sliderContent(o.(map[string]any)["content"]), a, time, scoring)
 						if err != nil {
-							log.Printf("Error occured @10: %v", err)
+							log.Printf("Error occured @8: %v", err)
 							return
 						}
 
 						ansRes[i] = PoolAnswer{
 							ID:      sqID,
 							Type:    sqType,
-							Content: r.Answers,
+							Content: r,
+						}
+						if r.Marks != nil {
+							marksRes += *r.Marks
 						}
-						marksRes += *r.Marks
 						timeRes = r.Time
-					}
-				}
+					case util.Hotspot:
+						a := qAns[I].([]any)
 
-				answers = PoolAnswerResponse{
-					Answers: ansRes,
-					Marks:   marksRes,
-					Time:    timeRes,
-				}
+						r, err := h.Service.CalculateHotspot(c, mod.Status, hotspotContent(o.(map[string]any)["content"]), a, time, scoring)
+						if err != nil {
+							log.Printf("Error occured @8: %v", err)
+							return
+						}
 
-				val, err := json.MarshalIndent(answers, "", "  ")
-				if err != nil {
-					log.Printf("Error occured @11: %v", err)
-					return
-				}
-				log.Printf("Value: %v", string(val))
-			}
-		}
-	}
-	if isHost && mod.CurrentQuestion > 0 && mod.Status == util.RevealingAnswer {
-		qid, ok := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["id"].(string)
-		if !ok {
-			log.Printf("Error occured @708: %v", err)
-			return
-		}
-		qAns, ok := mod.Answers[mod.Orders[mod.CurrentQuestion-1]-1].([]any)
-		if !ok {
-			log.Printf("Error occured @792: Type assertion failed")
-			return
-		}
-		qType, ok := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["type"].(string)
-		if !ok {
-			log.Printf("Error occured @708: %v", err)
-			return
+						ansRes[i] = PoolAnswer{
+							ID:      sqID,
+							Type:    sqType,
+							Content: r,
+						}
+						if r.Marks != nil {
+							marksRes += *r.Marks
+						}
+						timeRes = r.Time
+					case util.Ordering:
+						a := qAns[I].([]any)
+
+						sqScoring := scoring
+						if sq, ok := subquestion(question, I).(map[string]any); ok {
+							sqScoring.SelectGrading, _ = sq["select_grading"].(string)
+						}
+
+						r, err := h.Service.CalculateOrdering(c, mod.Status, orderingContent(o.(map[string]any)["content"]), a, time, sqScoring)
+						if err != nil {
+							log.Printf("Error occured @8: %v", err)
+							return
+						}
+
+						ansRes[i] = PoolAnswer{
+							ID:      sqID,
+							Type:    sqType,
+							Content: r.Answers,
+						}
+						if r.Marks != nil {
+							marksRes += *r.Marks
+						}
+						timeRes = r.Time
+					case util.Matching:
+						opt, ok := o.(map[string]any)["content"].([]any)
+						if !ok {
+							log.Printf("Error occured @9: Type assertion failed")
+							return
+						}
+						a := qAns[I].([]any)
+
+						r, err := h.Service.CalculateMatching(c, mod.Status, opt, a, time, scoring)
+						if err != nil {
+							log.Printf("Error occured @10: %v", err)
+							return
+						}
+
+						ansRes[i] = PoolAnswer{
+							ID:      sqID,
+							Type:    sqType,
+							Content: r.Answers,
+						}
+						marksRes += *r.Marks
+						timeRes = r.Time
+					}
+				}
+
+				answers = PoolAnswerResponse{
+					Answers: ansRes,
+					Marks:   marksRes,
+					Time:    timeRes,
+				}
+
+				val, err := json.MarshalIndent(answers, "", "  ")
+				if err != nil {
+					log.Printf("Error occured @11: %v", err)
+					return
+				}
+				log.Printf("Value: %v", string(val))
+			}
+		}
+	}
+	if (isHost || isSpectator || mod.assists(userID)) && !mod.Config.ShuffleConfig.PerParticipant && mod.CurrentQuestion > 0 && mod.Status == util.RevealingAnswer {
+		qid, ok := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["id"].(string)
+		if !ok {
+			log.Printf("Error occured @708: %v", err)
+			return
+		}
+		qAns, ok := mod.Answers[mod.Orders[mod.CurrentQuestion-1]-1].([]any)
+		if !ok {
+			log.Printf("Error occured @792: Type assertion failed")
+			return
+		}
+		qType, ok := mod.Questions[mod.Orders[mod.CurrentQuestion-1]-1].(map[string]any)["type"].(string)
+		if !ok {
+			log.Printf("Error occured @708: %v", err)
+			return
 		}
 
 		answers, err = h.Service.GetAnswersResponseForHost(context.Background(), qid, qType, qAns, mod.AnswerCounts)
@@ -688,7 +967,7 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 	}
 
 	rank := -1
-	if !isHost {
+	if !isHost && !isSpectator {
 		rank, err = h.Service.GetRank(c, lqsID, p.ID)
 		if err != nil {
 			log.Printf("Error occured: %v", err)
@@ -696,26 +975,73 @@ func (h *Handler) JoinLiveQuizSession(c *gin.Context) {
 		}
 	}
 
+	var resume *Resume
+	if lastSeq != nil {
+		missed, complete, err := h.hub.GetMissedMessages(cl, *lastSeq, mod.Roles)
+		if err != nil {
+			log.Printf("Error occured: %v", err)
+			return
+		}
+		countdown, err := h.hub.GetLastCountdown(lqsID)
+		if err != nil {
+			log.Printf("Error occured: %v", err)
+			return
+		}
+		var question any
+		if !mod.Config.ShuffleConfig.PerParticipant && mod.CurrentQuestion > 0 && mod.Status != util.Idle && mod.Status != util.Starting {
+			question = mod.questionFor(cl.ID, cl.IsHost)
+		}
+		resume = &Resume{
+			Messages:  missed,
+			Complete:  complete,
+			Question:  question,
+			Countdown: countdown,
+		}
+	}
+
 	go cl.writeMessage()
 	h.hub.Converse <- &Message{
 		Content: Content{
 			Type: util.JoinLQS,
 			Payload: JoinedMessage{
-				Code:    code,
-				ID:      cl.ID,
-				Name:    cl.DisplayName,
-				Emoji:   cl.DisplayEmoji,
-				Color:   cl.DisplayColor,
-				Marks:   p.Marks,
-				IsHost:  cl.IsHost,
-				Answers: answers,
-				Rank:    rank,
+				Code:        code,
+				ID:          cl.ID,
+				Name:        cl.DisplayName,
+				Emoji:       cl.DisplayEmoji,
+				Color:       cl.DisplayColor,
+				Marks:       p.Marks,
+				IsHost:      cl.IsHost,
+				IsSpectator: cl.IsSpectator,
+				Answers:     answers,
+				Rank:        rank,
+				Resume:      resume,
 			},
 		},
 		LiveQuizSessionID: lqsID,
 		ClientID:          cl.ID,
 		UserID:            cl.UserID,
 	}
+	if !isHost && !isSpectator {
+		rejoinToken, err := util.GenerateRejoinToken(cl.ID, lqsID, time.Now().Add(rejoinTokenDuration), os.Getenv("REJOIN_TOKEN_SECRET"))
+		if err != nil {
+			log.Printf("Error occured: %v", err)
+		} else {
+			// Sent straight down this connection so the token never lands in
+			// the replay buffer of the session.
+			cl.send(&Message{
+				Content: Content{
+					Type:    util.RejoinToken,
+					Payload: gin.H{"token": rejoinToken},
+				},
+				LiveQuizSessionID: lqsID,
+				ClientID:          cl.ID,
+				UserID:            cl.UserID,
+			})
+		}
+	}
+	if !isHost && !isSpectator && mod.Config.ShuffleConfig.PerParticipant && mod.Status == util.Answering {
+		cl.sendOwnQuestion(h, mod, cl.ID)
+	}
 	cl.readMessage(h)
 }
 
@@ -807,6 +1133,8 @@ func (h *Handler) InterruptCountdown(c *gin.Context) {
 		return
 	}
 
+	h.hub.Interrupt(mod.LiveQuizSessionID)
+
 	c.JSON(http.StatusOK, gin.H{"message": "Successfully interrupted the countdown"})
 }
 
@@ -1659,6 +1987,734 @@ func (h *Handler) InterruptCountdown(c *gin.Context) {
 // 	}
 // }
 
+// CreateJoinRequest puts a participant in the waiting room of a session that
+// needs the host's approval to join.
+func (h *Handler) CreateJoinRequest(c *gin.Context) {
+	var req CreateJoinRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	code := c.Param("code")
+	lqs, ok := h.hub.GetLiveQuizSessionByCode(code)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No such session exists"})
+		return
+	}
+
+	mod, err := h.Service.GetLiveQuizSessionCache(c, code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !mod.Config.JoinConfig.Approval {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The session does not need approval to join"})
+		return
+	}
+	if !h.checkJoinPolicy(c, mod.Config.JoinConfig, req.Passcode) {
+		return
+	}
+
+	jr := &JoinRequest{
+		ID:        uuid.New(),
+		UserID:    authenticatedUserID(c),
+		Name:      req.Name,
+		Emoji:     req.Emoji,
+		Color:     req.Color,
+		Status:    util.JoinPending,
+		CreatedAt: time.Now(),
+	}
+	if err := h.Service.CreateJoinRequest(c, code, jr); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	members, err := h.hub.GetMembers(lqs.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	for _, m := range members {
+		if !m.IsHost && (m.IsSpectator || !mod.trusts(m.UserID, util.ApproveJoin)) {
+			continue
+		}
+		h.hub.Inject <- &Message{
+			Content: Content{
+				Type:    util.JoinRequest,
+				Payload: jr,
+			},
+			LiveQuizSessionID: lqs.ID,
+			ClientID:          m.ID,
+			UserID:            &lqs.HostID,
+		}
+	}
+
+	c.JSON(http.StatusOK, jr)
+}
+
+// GetJoinRequest lets a participant in the waiting room see whether the host
+// let them in.
+func (h *Handler) GetJoinRequest(c *gin.Context) {
+	jr, err := h.Service.GetJoinRequest(c, c.Param("code"), c.Param("rid"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if jr == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No such join request exists"})
+		return
+	}
+
+	c.JSON(http.StatusOK, jr)
+}
+
+// GetJoinRequests lists the waiting room of a session for its host and the
+// users the host trusted with it.
+func (h *Handler) GetJoinRequests(c *gin.Context) {
+	uid, ok := c.Get("uid")
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	userID, err := uuid.Parse(uid.(string))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	code := c.Param("code")
+	lqs, ok := h.hub.GetLiveQuizSessionByCode(code)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No such session exists"})
+		return
+	}
+	if lqs.HostID != userID {
+		mod, err := h.Service.GetLiveQuizSessionCache(c, code)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !mod.trusts(&userID, util.ApproveJoin) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only the host and moderators can see the waiting room"})
+			return
+		}
+	}
+
+	jrs, err := h.Service.GetJoinRequests(c, code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, jrs)
+}
+
+// admitParticipant enforces the join policy of a session on a participant
+// about to join it. A participant let in from the waiting room joins under
+// the ID of their approved join request.
+func (h *Handler) admitParticipant(c *gin.Context, mod *Cache, code string) (*uuid.UUID, bool) {
+	policy := mod.Config.JoinConfig
+	if !h.checkJoinPolicy(c, policy, c.Query("passcode")) {
+		return nil, false
+	}
+
+	if policy.Approval {
+		jr, err := h.Service.GetJoinRequest(c, code, c.Query("request"))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return nil, false
+		}
+		if jr == nil || jr.Status != util.JoinApproved {
+			c.JSON(http.StatusForbidden, gin.H{"error": "The host has not let you in"})
+			return nil, false
+		}
+		return &jr.ID, true
+	}
+
+	return nil, true
+}
+
+// checkJoinPolicy checks the passcode and the allowlist of a session.
+func (h *Handler) checkJoinPolicy(c *gin.Context, policy JoinConfigurations, passcode string) bool {
+	if policy.Passcode != "" && util.CheckPassword(policy.Passcode, passcode) != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Wrong passcode"})
+		return false
+	}
+
+	if len(policy.Allowlist) > 0 {
+		userID := authenticatedUserID(c)
+		if userID == nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Sign in to join this session"})
+			return false
+		}
+		email, err := h.Service.GetUserEmail(c, *userID)
+		if err != nil || !util.IsAllowed(email, policy.Allowlist) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You are not allowed to join this session"})
+			return false
+		}
+	}
+
+	return true
+}
+
+// authenticatedUserID is the user signed in with the request, if any. Unlike
+// the uid query parameter, it cannot be made up by the client.
+func authenticatedUserID(c *gin.Context) *uuid.UUID {
+	uid, ok := c.Get("uid")
+	if !ok {
+		return nil
+	}
+	parsedUID, err := uuid.Parse(uid.(string))
+	if err != nil {
+		return nil
+	}
+	return &parsedUID
+}
+
+// ---------- Assignment related handlers ---------- //
+func (h *Handler) CreateAssignment(c *gin.Context) {
+	var req CreateAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	uid, ok := c.Get("uid")
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+	hostID, err := uuid.Parse(uid.(string))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	latestQuizID, err := h.quizService.GetLatestQuizVersionByID(c, req.QuizID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	quizTitle, err := h.quizService.GetQuizHistoryByID(c, *latestQuizID, hostID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	questions, err := h.quizService.GetQuestionsByQuizIDForLQS(c, *latestQuizID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !req.ClosesAt.After(req.OpensAt) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "An assignment must close after it opens"})
+		return
+	}
+
+	lqs, err := h.Service.CreateAssignment(c, *latestQuizID, hostID, req.OpensAt, req.ClosesAt, req.Config)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, &AssignmentResponse{
+		ID:            lqs.ID,
+		QuizID:        lqs.QuizID,
+		QuizTitle:     quizTitle.Title,
+		QuestionCount: len(questions),
+		OpensAt:       *lqs.OpensAt,
+		ClosesAt:      *lqs.ClosesAt,
+	})
+}
+
+func (h *Handler) JoinAssignment(c *gin.Context) {
+	var req JoinAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	lqs, ok := h.openAssignment(c)
+	if !ok {
+		return
+	}
+
+	userID := authenticatedUserID(c)
+	if userID != nil && *userID == lqs.HostID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The host cannot take their own assignment"})
+		return
+	}
+
+	mod, err := h.assignmentCache(c, lqs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	p, err := h.Service.CreateParticipant(c, &Participant{
+		ID:                uuid.New(),
+		UserID:            userID,
+		LiveQuizSessionID: lqs.ID,
+		Status:            util.Joined,
+		Marks:             0,
+		Name:              req.Name,
+		Emoji:             req.Emoji,
+		Color:             req.Color,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The first question is only timed once the participant opens it.
+	progress := mod.newProgress()
+	progress.StartedAt = time.Time{}
+	if err := h.Service.SaveAssignmentProgress(c, lqs.ID, p.ID, progress); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The token is all that lets the participant back into the assignment,
+	// so it lasts as long as the assignment is open.
+	token, err := util.GenerateRejoinToken(p.ID, lqs.ID, *lqs.ClosesAt, os.Getenv("REJOIN_TOKEN_SECRET"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, &JoinAssignmentResponse{
+		Participant: *p,
+		Progress: ProgressPayload{
+			ParticipantID:   p.ID,
+			CurrentQuestion: progress.CurrentQuestion,
+			QuestionCount:   len(progress.Orders),
+		},
+		Token: token,
+	})
+}
+
+// GetAssignmentQuestion returns the question a participant is on and starts
+// its timer the first time it is opened. Questions whose time ran out without
+// an answer are skipped.
+func (h *Handler) GetAssignmentQuestion(c *gin.Context) {
+	lqs, p, progress, mod, ok := h.assignmentParticipant(c)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	if !progress.isDone() && progress.StartedAt.IsZero() {
+		progress.StartedAt = now
+	}
+	if err := h.Service.SaveAssignmentProgress(c, lqs.ID, p.ID, progress); err != nil {
+		if errors.Is(err, ErrProgressMoved) {
+			c.JSON(http.StatusConflict, gin.H{"error": "The progress moved on, try again"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	res := &AssignmentQuestionResponse{
+		Progress: ProgressPayload{
+			ParticipantID:   p.ID,
+			CurrentQuestion: progress.CurrentQuestion,
+			QuestionCount:   len(progress.Orders),
+		},
+	}
+	if !progress.isDone() {
+		idx := progress.Orders[progress.CurrentQuestion-1] - 1
+		deadline := assignmentDeadline(lqs, mod.Questions[idx], progress)
+		res.Question = mod.ownQuestion(progress, p.ID)
+		res.Deadline = &deadline
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// AnswerAssignmentQuestion grades the answer to the question a participant is
+// on and moves them on to the next one. The time taken is measured here, from
+// when the question was opened.
+func (h *Handler) AnswerAssignmentQuestion(c *gin.Context) {
+	var answer map[string]any
+	if err := c.ShouldBindJSON(&answer); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	lqs, p, progress, mod, ok := h.assignmentParticipant(c)
+	if !ok {
+		return
+	}
+
+	if progress.isDone() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No question to answer"})
+		return
+	}
+	if progress.StartedAt.IsZero() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The question has not been opened"})
+		return
+	}
+
+	idx := progress.Orders[progress.CurrentQuestion-1] - 1
+	question := mod.Questions[idx].(map[string]any)
+	qid, _ := question["id"].(string)
+	qType, _ := question["type"].(string)
+
+	elapsed := time.Since(progress.StartedAt)
+	if limit := assignmentDeadline(lqs, question, progress).Sub(progress.StartedAt); elapsed > limit {
+		elapsed = limit
+	}
+	answer["time"] = math.Floor(float64(elapsed.Milliseconds()) / 100)
+	if qType == util.Pool {
+		answer["options"] = poolAnswersByIndex(question, answer["options"])
+	}
+
+	// The answer only counts if the progress is moved on with it, so that
+	// the same question cannot be answered twice.
+	mod.Streaks = map[string]int{p.ID.String(): progress.Streak}
+	var ansRes any
+	err := h.Service.AnswerAssignment(c, lqs.ID, p.ID, progress, func(serv Service) error {
+		res, marks, err := h.saveOwnResponse(c, serv, mod, lqs.ID, p.ID, idx, progress, answer)
+		if err != nil {
+			return err
+		}
+		ansRes = res
+
+		if util.IsGraded(qType) && !mod.isExempted(qid) {
+			if marks > 0 {
+				progress.Streak++
+			} else {
+				progress.Streak = 0
+			}
+		}
+		progress.next()
+		return nil
+	})
+	if errors.Is(err, ErrProgressMoved) {
+		c.JSON(http.StatusConflict, gin.H{"error": "The question was already answered"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	p, err = h.Service.GetParticipantByID(c, p.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, &AssignmentAnswerResponse{
+		Answers: ansRes,
+		Marks:   p.Marks,
+		Progress: ProgressPayload{
+			ParticipantID:   p.ID,
+			CurrentQuestion: progress.CurrentQuestion,
+			QuestionCount:   len(progress.Orders),
+		},
+	})
+}
+
+// openAssignment looks up the assignment of the request and checks that it
+// takes answers.
+func (h *Handler) openAssignment(c *gin.Context) (*Session, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assignment ID"})
+		return nil, false
+	}
+
+	lqs, err := h.Service.GetLiveQuizSessionBySessionID(c, id)
+	if err != nil || lqs.Mode != util.AssignmentMode {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No such assignment exists"})
+		return nil, false
+	}
+
+	if !lqs.isOpen(time.Now()) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "The assignment is not open"})
+		return nil, false
+	}
+
+	return &lqs.Session, true
+}
+
+// assignmentParticipant looks up the participant of the request along with
+// their progress, after skipping the questions they ran out of time on. Only
+// the participant's own token lets them in.
+func (h *Handler) assignmentParticipant(c *gin.Context) (*Session, *Participant, *Progress, *Cache, bool) {
+	lqs, ok := h.openAssignment(c)
+	if !ok {
+		return nil, nil, nil, nil, false
+	}
+
+	pid, err := uuid.Parse(c.Param("pid"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid participant ID"})
+		return nil, nil, nil, nil, false
+	}
+	claims, err := util.DecodeRejoinToken(c.GetHeader(participantTokenHeader), os.Getenv("REJOIN_TOKEN_SECRET"))
+	if err != nil || claims.ParticipantID != pid || claims.LiveQuizSessionID != lqs.ID {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid participant token"})
+		return nil, nil, nil, nil, false
+	}
+	p, err := h.Service.GetParticipantByID(c, pid)
+	if err != nil || p.LiveQuizSessionID != lqs.ID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No such participant exists"})
+		return nil, nil, nil, nil, false
+	}
+
+	progress, err := h.Service.GetAssignmentProgress(c, pid)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, nil, nil, nil, false
+	}
+
+	mod, err := h.assignmentCache(c, lqs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, nil, nil, nil, false
+	}
+
+	// A question left without an answer scores nothing, as it would have
+	// live, and breaks the streak.
+	now := time.Now()
+	for !progress.isDone() && !progress.StartedAt.IsZero() {
+		idx := progress.Orders[progress.CurrentQuestion-1] - 1
+		if now.Before(assignmentDeadline(lqs, mod.Questions[idx], progress).Add(answerGracePeriod)) {
+			break
+		}
+		if qType, _ := mod.Questions[idx].(map[string]any)["type"].(string); util.IsGraded(qType) && !mod.isExempted(questionID(mod.Questions[idx])) {
+			progress.Streak = 0
+		}
+		progress.next()
+	}
+
+	return lqs, p, progress, mod, true
+}
+
+// assignmentCache sets up what the session cache holds for a live session, so
+// that assignments are scored the same way.
+func (h *Handler) assignmentCache(ctx context.Context, lqs *Session) (*Cache, error) {
+	var config Configurations
+	if lqs.Config != nil {
+		if err := json.Unmarshal([]byte(*lqs.Config), &config); err != nil {
+			return nil, err
+		}
+	}
+
+	questions, err := h.quizService.GetQuestionsByQuizIDForLQS(ctx, lqs.QuizID)
+	if err != nil {
+		return nil, err
+	}
+	answers, err := h.quizService.GetAnswersByQuizIDForLQS(ctx, lqs.QuizID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{
+		LiveQuizSessionID: lqs.ID,
+		QuizID:            lqs.QuizID,
+		HostID:            lqs.HostID,
+		QuestionCount:     len(questions),
+		Questions:         questions,
+		Answers:           answers,
+		AnswerCounts:      make(map[string]map[string]int),
+		Status:            util.Answering,
+		Config:            config,
+		Streaks:           make(map[string]int),
+		Exempted:          util.ExemptedIDs(lqs.ExemptedQuestionIDs),
+	}, nil
+}
+
+// assignmentDeadline is when the question a participant is on stops taking
+// answers: when its time runs out or when the assignment closes, whichever
+// comes first.
+func assignmentDeadline(lqs *Session, question any, p *Progress) time.Time {
+	deadline := *lqs.ClosesAt
+	q, _ := question.(map[string]any)
+	if timeLimit, _ := q["time_limit"].(float64); timeLimit > 0 {
+		if d := p.StartedAt.Add(time.Duration(timeLimit) * time.Second); d.Before(deadline) {
+			deadline = d
+		}
+	}
+	return deadline
+}
+
+// saveOwnResponse grades and saves the answer of a participant to the question
+// they are on, the way revealAnswer does for everyone at once in a live session.
+func (h *Handler) saveOwnResponse(ctx context.Context, serv Service, mod *Cache, lqsID uuid.UUID, pid uuid.UUID, idx int, progress *Progress, answer map[string]any) (any, int, error) {
+	question := mod.Questions[idx].(map[string]any)
+	qid, _ := question["id"].(string)
+	qType, _ := question["type"].(string)
+	qTimeLimit, _ := question["time_limit"].(float64)
+	qTimeFactor, _ := question["time_factor"].(float64)
+	if haveTimeFactor, _ := question["have_time_factor"].(bool); !haveTimeFactor {
+		qTimeFactor = 0
+	}
+	qAns, ok := mod.Answers[idx].([]any)
+	if !ok {
+		return nil, 0, errors.New("invalid type assertion")
+	}
+	timeTaken, _ := answer["time"].(float64)
+
+	if qType != util.Pool {
+		questionID, err := uuid.Parse(qid)
+		if err != nil {
+			return nil, 0, err
+		}
+		res, _, marks, err := h.saveOwnAnswer(ctx, serv, qType, answer["options"], qAns, timeTaken, mod.scoring(question, qTimeLimit, qTimeFactor, pid.String()), &Response{
+			ID:                uuid.New(),
+			LiveQuizSessionID: lqsID,
+			QuestionID:        questionID,
+			ParticipantID:     pid,
+			Type:              qType,
+		})
+		return res, marks, err
+	}
+
+	options, ok := answer["options"].(map[string]any)
+	if !ok {
+		return nil, 0, errors.New("invalid type assertion")
+	}
+
+	ansRes := make(map[string]PoolAnswer, 0)
+	marksRes := 0
+	for i, o := range options {
+		I, err := strconv.Atoi(i)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !slices.Contains(progress.SubQuestions[qid], I) || I >= len(qAns) {
+			return nil, 0, errors.New("question was not drawn for the participant")
+		}
+		sqType, ok := o.(map[string]any)["type"].(string)
+		if !ok {
+			return nil, 0, errors.New("invalid type assertion")
+		}
+		sqID, ok := o.(map[string]any)["qid"].(string)
+		if !ok {
+			return nil, 0, errors.New("invalid type assertion")
+		}
+		subqID, err := uuid.Parse(sqID)
+		if err != nil {
+			return nil, 0, err
+		}
+		ans, ok := qAns[I].([]any)
+		if !ok {
+			return nil, 0, errors.New("invalid type assertion")
+		}
+
+		_, content, marks, err := h.saveOwnAnswer(ctx, serv, sqType, o.(map[string]any)["content"], ans, timeTaken, mod.scoring(subquestion(question, I), qTimeLimit, qTimeFactor, pid.String()), &Response{
+			ID:                uuid.New(),
+			LiveQuizSessionID: lqsID,
+			QuestionID:        subqID,
+			ParticipantID:     pid,
+			Type:              sqType,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		ansRes[i] = PoolAnswer{
+			ID:      sqID,
+			Type:    sqType,
+			Content: content,
+		}
+		marksRes += marks
+	}
+
+	return PoolAnswerResponse{
+		Answers: ansRes,
+		Marks:   marksRes,
+		Time:    int(timeTaken),
+	}, marksRes, nil
+}
+
+// saveOwnAnswer grades and saves an answer to a single question. Besides the
+// result, it returns what a pool shows of it.
+func (h *Handler) saveOwnAnswer(ctx context.Context, serv Service, qType string, options any, answers []any, timeTaken float64, scoring Scoring, response *Response) (any, any, int, error) {
+	// Nobody sees the answer counts of an assignment, so they are not kept.
+	counts := make(map[string]int)
+
+	switch qType {
+	case util.Choice, util.TrueFalse:
+		co, _ := options.([]any)
+		res, _, err := serv.CalculateAndSaveChoiceResponse(ctx, co, answers, counts, timeTaken, scoring, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return res, res.Answers, *res.Marks, nil
+	case util.Poll:
+		po, _ := options.([]any)
+		res, _, err := serv.SavePollResponse(ctx, po, answers, counts, timeTaken, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return res, res.Answers, 0, nil
+	case util.WordCloud:
+		res, _, err := serv.SaveWordCloudResponse(ctx, wordCloudContent(options), counts, timeTaken, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return res, res.Answers, 0, nil
+	case util.FillBlank:
+		to, _ := options.([]any)
+		res, err := serv.CalculateAndSaveFillBlankResponse(ctx, to, answers, timeTaken, scoring, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return res, res.Answers, *res.Marks, nil
+	case util.Paragraph:
+		content, _ := options.(string)
+		res, err := serv.CalculateAndSaveParagraphResponse(ctx, content, answers, timeTaken, scoring, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		if r, ok := res.(TextAnswerResponse); ok {
+			return res, r.Answers, *r.Marks, nil
+		}
+		return res, res, 0, nil
+	case util.Numeric:
+		res, _, err := serv.CalculateAndSaveNumericResponse(ctx, numericContent(options), answers, counts, timeTaken, scoring, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return res, res, *res.Marks, nil
+	case util.Slider:
+		res, _, err := serv.CalculateAndSaveSliderResponse(ctx, sliderContent(options), answers, counts, timeTaken, scoring, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return res, res, *res.Marks, nil
+	case util.Hotspot:
+		res, _, err := serv.CalculateAndSaveHotspotResponse(ctx, hotspotContent(options), answers, counts, timeTaken, scoring, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return res, res, *res.Marks, nil
+	case util.Ordering:
+		res, err := serv.CalculateAndSaveOrderingResponse(ctx, orderingContent(options), answers, timeTaken, scoring, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return res, res.Answers, *res.Marks, nil
+	case util.Matching:
+		mo, _ := options.([]any)
+		res, err := serv.CalculateAndSaveMatchingResponse(ctx, mo, answers, timeTaken, scoring, response)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return res, res.Answers, *res.Marks, nil
+	}
+
+	return nil, nil, 0, errors.New("unsupported question type")
+}
+
 func (h *Handler) UpdateModerator(c *gin.Context) {
 	code := c.Param("code")
 
@@ -1685,11 +2741,8 @@ func (h *Handler) UpdateModerator(c *gin.Context) {
 	}
 
 	isHost := false
-	for _, s := range h.hub.LiveQuizSessions {
-		if s.Code == code && userID != nil && s.HostID == *userID {
-			isHost = true
-			break
-		}
+	if s, ok := h.hub.GetLiveQuizSessionByCode(code); ok && userID != nil && s.HostID == *userID {
+		isHost = true
 	}
 
 	mod, err := h.Service.GetLiveQuizSessionCache(c, code)
@@ -1700,6 +2753,7 @@ func (h *Handler) UpdateModerator(c *gin.Context) {
 
 	if !isHost {
 		mod.Answers = make([]any, 0)
+		mod.Config.JoinConfig = JoinConfigurations{}
 	}
 
 	c.JSON(http.StatusOK, mod)
@@ -1,82 +1,614 @@
 package v1
 
 import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
 	"github.com/Live-Quiz-Project/Backend/internal/util"
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	hubSessionsKey    = "live:sessions"
+	hubClientsKey     = "live:clients:"
+	hubSeqKey         = "live:seq:"
+	hubReplayKey      = "live:replay:"
+	hubCountdownKey   = "live:countdown:"
+	hubChannel        = "live:channel:"
+	hubChannelPattern = "live:channel:*"
+
+	hubRegister   = "REGISTER"
+	hubUnregister = "UNREGISTER"
+	hubBroadcast  = "BROADCAST"
+	hubConverse   = "CONVERSE"
+	hubInject     = "INJECT"
+	hubTimer      = "TIMER"
+	hubEnd        = "END"
+
+	mailboxSize = 64
+	replaySize  = 200
+	replayTTL   = time.Duration(60*60*5) * time.Second
 )
 
 type Hub struct {
-	LiveQuizSessions map[uuid.UUID]*LiveQuizSession
-	Register         chan *Client
-	Unregister       chan *Client
-	Broadcast        chan *Message
-	Converse         chan *Message
-	Inject           chan *Message
+	sessions   map[uuid.UUID]*LiveQuizSession
+	mu         sync.RWMutex
+	Register   chan *Client
+	Unregister chan *Client
+	Broadcast  chan *Message
+	Converse   chan *Message
+	Inject     chan *Message
+	end        chan uuid.UUID
+	cache      *redis.Client
 }
 
-func NewHub() *Hub {
+// envelope is what travels over the Redis channel of a session so that every
+// replica can fan the message out to the clients connected to it. Register and
+// unregister envelopes never leave the replica and carry the client instead.
+type envelope struct {
+	Kind    string  `json:"kind"`
+	Message Message `json:"message"`
+	client  *Client
+}
+
+// Member is the replica-independent view of a client stored in Redis.
+type Member struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      *uuid.UUID `json:"uid"`
+	DisplayName string     `json:"display_name"`
+	IsHost      bool       `json:"isHost"`
+	IsSpectator bool       `json:"isSpectator"`
+	Status      string     `json:"status"`
+}
+
+func NewHub(cache *redis.Client) *Hub {
 	return &Hub{
-		LiveQuizSessions: make(map[uuid.UUID]*LiveQuizSession),
-		Register:         make(chan *Client),
-		Unregister:       make(chan *Client),
-		Broadcast:        make(chan *Message, 5),
-		Converse:         make(chan *Message, 5),
-		Inject:           make(chan *Message, 5),
+		sessions:   make(map[uuid.UUID]*LiveQuizSession),
+		Register:   make(chan *Client),
+		Unregister: make(chan *Client),
+		Broadcast:  make(chan *Message, 5),
+		Converse:   make(chan *Message, 5),
+		Inject:     make(chan *Message, 5),
+		end:        make(chan uuid.UUID),
+		cache:      cache,
 	}
 }
 
+// Run only routes: every session owns its goroutine and mailbox, so a busy
+// session cannot hold up the others.
 func (h *Hub) Run() {
+	go h.subscribe()
+
 	for {
 		select {
 		case cl := <-h.Register:
-			if _, ok := h.LiveQuizSessions[cl.LiveQuizSessionID]; ok {
-				lqs := h.LiveQuizSessions[cl.LiveQuizSessionID]
-				if _, ok := lqs.Clients[cl.ID]; !ok {
-					lqs.Clients[cl.ID] = cl
-				}
-			}
+			h.route(cl.LiveQuizSessionID, &envelope{Kind: hubRegister, client: cl})
 		case cl := <-h.Unregister:
-			if _, ok := h.LiveQuizSessions[cl.LiveQuizSessionID]; ok {
-				if _, ok := h.LiveQuizSessions[cl.LiveQuizSessionID].Clients[cl.ID]; ok {
-					if len(h.LiveQuizSessions[cl.LiveQuizSessionID].Clients) != 0 {
-						h.Broadcast <- &Message{
-							Content: Content{
-								Type:    util.LeaveLQS,
-								Payload: nil,
-							},
-							LiveQuizSessionID: cl.LiveQuizSessionID,
-							ClientID:          cl.ID,
-							UserID:            cl.UserID,
-						}
-					}
-					delete(h.LiveQuizSessions[cl.LiveQuizSessionID].Clients, cl.ID)
-					close(cl.Message)
-					cl.Conn.Close()
-				}
+			h.route(cl.LiveQuizSessionID, &envelope{Kind: hubUnregister, client: cl})
+		case m := <-h.Broadcast:
+			h.publish(hubBroadcast, m)
+		case m := <-h.Converse:
+			h.publish(hubConverse, m)
+		case m := <-h.Inject:
+			h.publish(hubInject, m)
+		case lqsID := <-h.end:
+			h.flush()
+			h.publish(hubEnd, &Message{
+				LiveQuizSessionID: lqsID,
+			})
+			if err := h.cache.Del(context.Background(), hubClientsKey+lqsID.String(), hubSeqKey+lqsID.String(), hubReplayKey+lqsID.String(), hubCountdownKey+lqsID.String()).Err(); err != nil {
+				log.Printf("Error occured while clearing live quiz session: %v", err)
 			}
+		}
+	}
+}
+
+// flush publishes whatever is still queued so that it reaches the clients
+// before the session ends.
+func (h *Hub) flush() {
+	for {
+		select {
 		case m := <-h.Broadcast:
-			if _, ok := h.LiveQuizSessions[m.LiveQuizSessionID]; ok {
-				for _, cl := range h.LiveQuizSessions[m.LiveQuizSessionID].Clients {
-					cl.Message <- m
+			h.publish(hubBroadcast, m)
+		case m := <-h.Converse:
+			h.publish(hubConverse, m)
+		case m := <-h.Inject:
+			h.publish(hubInject, m)
+		default:
+			return
+		}
+	}
+}
+
+// subscribe listens to the channels of every session and hands whatever
+// arrives to the mailbox of the session it belongs to.
+func (h *Hub) subscribe() {
+	sub := h.cache.PSubscribe(context.Background(), hubChannelPattern)
+	defer sub.Close()
+
+	for msg := range sub.Channel() {
+		var e envelope
+		if err := json.Unmarshal([]byte(msg.Payload), &e); err != nil {
+			log.Printf("Error occured while decoding hub message: %v", err)
+			continue
+		}
+		h.route(e.Message.LiveQuizSessionID, &e)
+	}
+}
+
+func (h *Hub) route(lqsID uuid.UUID, e *envelope) {
+	lqs, ok := h.GetLiveQuizSession(lqsID)
+	if !ok {
+		return
+	}
+	// The actor may have ended since it was looked up.
+	select {
+	case lqs.mailbox <- e:
+	case <-lqs.done:
+	}
+}
+
+// publish stamps m with the next sequence number of its session, keeps it in
+// the replay buffer and sends it to every replica. Countdown ticks are not
+// buffered; only the latest one is kept since it supersedes the others. Join
+// messages are left out too as they carry the replay themselves.
+func (h *Hub) publish(kind string, m *Message) {
+	ctx := context.Background()
+	lqsID := m.LiveQuizSessionID.String()
+
+	control := kind == hubTimer || kind == hubEnd
+	if !control {
+		seq, err := h.cache.Incr(ctx, hubSeqKey+lqsID).Result()
+		if err != nil {
+			log.Printf("Error occured while sequencing hub message: %v", err)
+			return
+		}
+		m.Seq = seq
+	}
+
+	val, err := json.Marshal(&envelope{
+		Kind:    kind,
+		Message: *m,
+	})
+	if err != nil {
+		log.Printf("Error occured while encoding hub message: %v", err)
+		return
+	}
+
+	if !control {
+		pipe := h.cache.TxPipeline()
+		switch m.Content.Type {
+		case util.Countdown:
+			pipe.Set(ctx, hubCountdownKey+lqsID, val, replayTTL)
+		case util.JoinLQS:
+		default:
+			pipe.RPush(ctx, hubReplayKey+lqsID, val)
+			pipe.LTrim(ctx, hubReplayKey+lqsID, -replaySize, -1)
+			pipe.Expire(ctx, hubReplayKey+lqsID, replayTTL)
+		}
+		pipe.Expire(ctx, hubSeqKey+lqsID, replayTTL)
+		if _, err := pipe.Exec(ctx); err != nil {
+			log.Printf("Error occured while buffering hub message: %v", err)
+		}
+	}
+
+	if err := h.cache.Publish(ctx, hubChannel+lqsID, val).Err(); err != nil {
+		log.Printf("Error occured while publishing hub message: %v", err)
+	}
+}
+
+// isFor reports whether the message carried by e should reach cl. What the
+// host is told also goes to whoever the host handed a role in roles.
+func (e *envelope) isFor(cl *Client, roles map[string]string) bool {
+	switch e.Kind {
+	case hubBroadcast:
+		return true
+	case hubConverse:
+		return cl.ID == e.Message.ClientID || cl.IsHost || rolesAssist(roles, cl.UserID)
+	case hubInject:
+		return cl.ID == e.Message.ClientID
+	}
+	return false
+}
+
+// ---------- Session actor ---------- //
+func (h *Hub) newLiveQuizSession(lqs *LiveQuizSession) *LiveQuizSession {
+	lqs.Clients = make(map[uuid.UUID]*Client)
+	lqs.mailbox = make(chan *envelope, mailboxSize)
+	lqs.done = make(chan struct{})
+	lqs.timer = make(chan Content, 8)
+	go lqs.run(h)
+	return lqs
+}
+
+func (lqs *LiveQuizSession) run(h *Hub) {
+	defer lqs.stop()
+
+	for e := range lqs.mailbox {
+		m := &e.Message
+		switch e.Kind {
+		case hubRegister:
+			// A client resuming on a new connection takes over from its old
+			// one, which may not have been noticed to be gone yet.
+			if old, ok := lqs.Clients[e.client.ID]; ok && old != e.client {
+				close(old.Message)
+			}
+			lqs.Clients[e.client.ID] = e.client
+			h.addMember(e.client)
+		case hubUnregister:
+			cl := e.client
+			if lqs.Clients[cl.ID] == cl {
+				delete(lqs.Clients, cl.ID)
+				h.removeMember(lqs.ID, cl.ID)
+				h.publish(hubBroadcast, &Message{
+					Content: Content{
+						Type:    util.LeaveLQS,
+						Payload: nil,
+					},
+					LiveQuizSessionID: cl.LiveQuizSessionID,
+					ClientID:          cl.ID,
+					UserID:            cl.UserID,
+				})
+				close(cl.Message)
+				cl.Conn.Close()
+			}
+		case hubBroadcast, hubConverse:
+			if m.Content.Type == util.AssignRole || m.Content.Type == util.RevokeRole {
+				lqs.forgetRoles()
+			}
+			var roles map[string]string
+			if e.Kind == hubConverse {
+				var err error
+				if roles, err = lqs.getRoles(func() (map[string]string, error) { return h.loadRoles(lqs.Code) }); err != nil {
+					log.Printf("Error occured while loading roles of session %v: %v", lqs.ID, err)
 				}
 			}
-		case m := <-h.Converse:
-			if _, ok := h.LiveQuizSessions[m.LiveQuizSessionID]; ok {
-				for _, cl := range h.LiveQuizSessions[m.LiveQuizSessionID].Clients {
-					if cl.ID == m.ClientID || cl.IsHost {
-						cl.Message <- m
-					}
+			for _, cl := range lqs.Clients {
+				if e.isFor(cl, roles) {
+					cl.send(m)
 				}
 			}
-		case m := <-h.Inject:
-			if _, ok := h.LiveQuizSessions[m.LiveQuizSessionID]; ok {
-				if _, ok = h.LiveQuizSessions[m.LiveQuizSessionID].Clients[m.ClientID]; ok {
-					h.LiveQuizSessions[m.LiveQuizSessionID].Clients[m.ClientID].Message <- m
-					if m.Content.Type == util.EndLQS {
-						delete(h.LiveQuizSessions[m.LiveQuizSessionID].Clients, m.ClientID)
-					}
+		case hubInject:
+			if cl, ok := lqs.Clients[m.ClientID]; ok {
+				cl.send(m)
+				if m.Content.Type == util.EndLQS {
+					delete(lqs.Clients, m.ClientID)
 				}
 			}
+		case hubTimer:
+			select {
+			case lqs.timer <- m.Content:
+			default:
+				log.Printf("Dropping timer control %v for session %v", m.Content.Type, lqs.ID)
+			}
+		case hubEnd:
+			h.mu.Lock()
+			delete(h.sessions, lqs.ID)
+			h.mu.Unlock()
+			return
+		}
+	}
+}
+
+// stop tells whoever routes to the session that its actor is gone and drops
+// whatever is left in the mailbox.
+func (lqs *LiveQuizSession) stop() {
+	close(lqs.done)
+	for {
+		select {
+		case <-lqs.mailbox:
+		default:
+			return
 		}
 	}
 }
+
+// getRoles returns the roles of the session, loading them once until they
+// are forgotten. A load that raced with a role change is not kept.
+func (lqs *LiveQuizSession) getRoles(load func() (map[string]string, error)) (map[string]string, error) {
+	lqs.rolesMu.Lock()
+	roles, gen := lqs.roles, lqs.rolesGen
+	lqs.rolesMu.Unlock()
+	if roles != nil {
+		return roles, nil
+	}
+
+	roles, err := load()
+	if err != nil {
+		return nil, err
+	}
+	if roles == nil {
+		roles = make(map[string]string)
+	}
+
+	lqs.rolesMu.Lock()
+	if gen == lqs.rolesGen {
+		lqs.roles = roles
+	}
+	lqs.rolesMu.Unlock()
+	return roles, nil
+}
+
+// loadRoles reads the roles handed out in a session straight from its cache.
+func (h *Hub) loadRoles(code string) (map[string]string, error) {
+	val, err := h.cache.Get(context.Background(), code).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var mod Cache
+	if err := json.Unmarshal([]byte(val), &mod); err != nil {
+		return nil, err
+	}
+	return mod.Roles, nil
+}
+
+// forgetRoles drops the roles of the session so that the next lookup reads
+// them from the cache again.
+func (lqs *LiveQuizSession) forgetRoles() {
+	lqs.rolesMu.Lock()
+	defer lqs.rolesMu.Unlock()
+
+	lqs.roles = nil
+	lqs.rolesGen++
+}
+
+// ---------- Session registry ---------- //
+func (h *Hub) AddLiveQuizSession(lqs *LiveQuizSession) error {
+	val, err := json.Marshal(lqs)
+	if err != nil {
+		return err
+	}
+
+	if err := h.cache.HSet(context.Background(), hubSessionsKey, lqs.ID.String(), val).Err(); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	h.sessions[lqs.ID] = h.newLiveQuizSession(lqs)
+	h.mu.Unlock()
+	return nil
+}
+
+func (h *Hub) GetLiveQuizSession(lqsID uuid.UUID) (*LiveQuizSession, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	lqs, ok := h.sessions[lqsID]
+	return lqs, ok
+}
+
+// GetLiveQuizSessions returns the sessions this replica is currently serving.
+func (h *Hub) GetLiveQuizSessions() []*LiveQuizSession {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	sessions := make([]*LiveQuizSession, 0, len(h.sessions))
+	for _, s := range h.sessions {
+		sessions = append(sessions, s)
+	}
+
+	return sessions
+}
+
+// GetLiveQuizSessionByCode looks the session up locally first and falls back
+// to the shared registry, so a replica can serve a session created elsewhere.
+func (h *Hub) GetLiveQuizSessionByCode(code string) (*LiveQuizSession, bool) {
+	for _, s := range h.GetLiveQuizSessions() {
+		if s.Code == code {
+			return s, true
+		}
+	}
+
+	sessions, err := h.getRegisteredLiveQuizSessions()
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return nil, false
+	}
+	for _, s := range sessions {
+		if s.Code == code {
+			return h.adopt(s), true
+		}
+	}
+
+	return nil, false
+}
+
+func (h *Hub) GetLiveQuizSessionByQuizID(quizID uuid.UUID) (*LiveQuizSession, bool) {
+	sessions, err := h.getRegisteredLiveQuizSessions()
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return nil, false
+	}
+	for _, s := range sessions {
+		if s.QuizID == quizID {
+			return h.adopt(s), true
+		}
+	}
+
+	return nil, false
+}
+
+func (h *Hub) GetCodes() []string {
+	codes := make([]string, 0)
+	sessions, err := h.getRegisteredLiveQuizSessions()
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return codes
+	}
+	for _, s := range sessions {
+		codes = append(codes, s.Code)
+	}
+
+	return codes
+}
+
+func (h *Hub) RemoveLiveQuizSession(lqsID uuid.UUID) error {
+	ctx := context.Background()
+	if err := h.cache.HDel(ctx, hubSessionsKey, lqsID.String()).Err(); err != nil {
+		return err
+	}
+
+	// Ending goes through Run so it cannot overtake the messages queued
+	// before it.
+	h.end <- lqsID
+	return nil
+}
+
+// Interrupt cuts the running countdown of a session short on whichever
+// replica is driving it.
+func (h *Hub) Interrupt(lqsID uuid.UUID) {
+	h.ControlTimer(lqsID, util.Intterrupt, 0)
+}
+
+// ControlTimer pauses, resumes, extends or interrupts the running countdown of
+// a session on whichever replica is driving it.
+func (h *Hub) ControlTimer(lqsID uuid.UUID, action string, seconds int) {
+	h.publish(hubTimer, &Message{
+		Content: Content{
+			Type:    action,
+			Payload: seconds,
+		},
+		LiveQuizSessionID: lqsID,
+	})
+}
+
+// adopt starts serving a registered session on this replica unless it
+// already is.
+func (h *Hub) adopt(lqs *LiveQuizSession) *LiveQuizSession {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if s, ok := h.sessions[lqs.ID]; ok {
+		return s
+	}
+	h.sessions[lqs.ID] = h.newLiveQuizSession(lqs)
+	return h.sessions[lqs.ID]
+}
+
+func (h *Hub) getRegisteredLiveQuizSessions() ([]*LiveQuizSession, error) {
+	vals, err := h.cache.HGetAll(context.Background(), hubSessionsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*LiveQuizSession, 0, len(vals))
+	for _, v := range vals {
+		var lqs LiveQuizSession
+		if err := json.Unmarshal([]byte(v), &lqs); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &lqs)
+	}
+
+	return sessions, nil
+}
+
+// ---------- Replay ---------- //
+
+// GetMissedMessages returns the buffered messages meant for cl that came after
+// lastSeq, given the roles currently handed out. The boolean is false when
+// some of them have already been trimmed from the buffer.
+func (h *Hub) GetMissedMessages(cl *Client, lastSeq int64, roles map[string]string) ([]Message, bool, error) {
+	vals, err := h.cache.LRange(context.Background(), hubReplayKey+cl.LiveQuizSessionID.String(), 0, -1).Result()
+	if err != nil {
+		return nil, false, err
+	}
+
+	missed := make([]Message, 0)
+	complete := true
+	for i, v := range vals {
+		var e envelope
+		if err := json.Unmarshal([]byte(v), &e); err != nil {
+			return nil, false, err
+		}
+		if i == 0 && e.Message.Seq > lastSeq+1 {
+			complete = false
+		}
+		if e.Message.Seq > lastSeq && e.isFor(cl, roles) {
+			missed = append(missed, e.Message)
+		}
+	}
+
+	return missed, complete, nil
+}
+
+func (h *Hub) GetLastCountdown(lqsID uuid.UUID) (*Message, error) {
+	val, err := h.cache.Get(context.Background(), hubCountdownKey+lqsID.String()).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var e envelope
+	if err := json.Unmarshal([]byte(val), &e); err != nil {
+		return nil, err
+	}
+
+	return &e.Message, nil
+}
+
+// ---------- Session membership ---------- //
+func (h *Hub) GetMembers(lqsID uuid.UUID) ([]Member, error) {
+	vals, err := h.cache.HGetAll(context.Background(), hubClientsKey+lqsID.String()).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]Member, 0, len(vals))
+	for _, v := range vals {
+		var m Member
+		if err := json.Unmarshal([]byte(v), &m); err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+func (h *Hub) GetHostID(lqsID uuid.UUID) (uuid.UUID, bool) {
+	members, err := h.GetMembers(lqsID)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return uuid.Nil, false
+	}
+	for _, m := range members {
+		if m.IsHost {
+			return m.ID, true
+		}
+	}
+
+	return uuid.Nil, false
+}
+
+func (h *Hub) addMember(cl *Client) {
+	val, err := json.Marshal(&Member{
+		ID:          cl.ID,
+		UserID:      cl.UserID,
+		DisplayName: cl.DisplayName,
+		IsHost:      cl.IsHost,
+		IsSpectator: cl.IsSpectator,
+		Status:      cl.Status,
+	})
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	if err := h.cache.HSet(context.Background(), hubClientsKey+cl.LiveQuizSessionID.String(), cl.ID.String(), val).Err(); err != nil {
+		log.Printf("Error occured: %v", err)
+	}
+}
+
+func (h *Hub) removeMember(lqsID uuid.UUID, id uuid.UUID) {
+	if err := h.cache.HDel(context.Background(), hubClientsKey+lqsID.String(), id.String()).Err(); err != nil {
+		log.Printf("Error occured: %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Live-Quiz-Project/Backend/internal/util"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveQuizSessionResumedClient(t *testing.T) {
+	// Nothing listens there, so the members kept in Redis are not written.
+	cache := redis.NewClient(&redis.Options{Addr: "127.0.0.1:0", MaxRetries: -1})
+	defer cache.Close()
+	h := NewHub(cache)
+	lqs := h.newLiveQuizSession(&LiveQuizSession{Session: Session{ID: uuid.New()}})
+	defer func() { lqs.mailbox <- &envelope{Kind: hubEnd} }()
+
+	id := uuid.New()
+	a := &Client{ID: id, LiveQuizSessionID: lqs.ID, Message: make(chan *Message, 10)}
+	b := &Client{ID: id, LiveQuizSessionID: lqs.ID, Message: make(chan *Message, 10)}
+
+	lqs.mailbox <- &envelope{Kind: hubRegister, client: a}
+	lqs.mailbox <- &envelope{Kind: hubRegister, client: b}
+	lqs.mailbox <- &envelope{Kind: hubUnregister, client: a}
+	lqs.mailbox <- &envelope{Kind: hubBroadcast, Message: Message{
+		Content:           Content{Type: util.DistQuestion},
+		LiveQuizSessionID: lqs.ID,
+	}}
+
+	select {
+	case m := <-b.Message:
+		assert.Equal(t, util.DistQuestion, m.Content.Type)
+	case <-time.After(time.Second):
+		t.Fatal("the resumed client got no broadcast")
+	}
+
+	_, open := <-a.Message
+	assert.False(t, open, "the old connection should be let go")
+}
+
+func TestEnvelopeIsFor(t *testing.T) {
+	sender, coHost, moderator, player := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	roles := map[string]string{
+		coHost.String():    util.CoHostRole,
+		moderator.String(): util.ModeratorRole,
+	}
+	converse := &envelope{Kind: hubConverse, Message: Message{ClientID: sender}}
+	inject := &envelope{Kind: hubInject, Message: Message{ClientID: sender}}
+
+	tests := []struct {
+		name string
+		e    *envelope
+		cl   *Client
+		want bool
+	}{
+		{"conversation with the sender", converse, &Client{ID: sender}, true},
+		{"conversation with the host", converse, &Client{ID: uuid.New(), IsHost: true}, true},
+		{"conversation with a co-host", converse, &Client{ID: uuid.New(), UserID: &coHost}, true},
+		{"conversation with a moderator", converse, &Client{ID: uuid.New(), UserID: &moderator}, true},
+		{"conversation with a player", converse, &Client{ID: uuid.New(), UserID: &player}, false},
+		{"conversation with a guest", converse, &Client{ID: uuid.New()}, false},
+		{"injection into a co-host", inject, &Client{ID: uuid.New(), UserID: &coHost}, false},
+		{"broadcast", &envelope{Kind: hubBroadcast}, &Client{ID: uuid.New()}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.e.isFor(tt.cl, roles))
+		})
+	}
+}
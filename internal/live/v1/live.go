@@ -2,8 +2,14 @@ package v1
 
 import (
 	"context"
+	"hash/fnv"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/Live-Quiz-Project/Backend/internal/util"
 	"github.com/google/uuid"
 )
 
@@ -14,6 +20,11 @@ type Session struct {
 	QuizID              uuid.UUID  `json:"quiz_id" gorm:"column:quiz_id;type:uuid;not null"`
 	Status              string     `json:"status" gorm:"column:status;not null"`
 	ExemptedQuestionIDs *string    `json:"exempted_question_ids" gorm:"column:exempted_question_ids"`
+	ScoringConfig       *string    `json:"scoring_config" gorm:"column:scoring_config;type:text"`
+	Mode                string     `json:"mode" gorm:"column:mode;type:text;not null"`
+	OpensAt             *time.Time `json:"opens_at" gorm:"column:opens_at;type:timestamptz"`
+	ClosesAt            *time.Time `json:"closes_at" gorm:"column:closes_at;type:timestamptz"`
+	Config              *string    `json:"config" gorm:"column:config;type:text"`
 	CreatedAt           time.Time  `json:"created_at" gorm:"column:created_at;type:timestamptz;not null"`
 	UpdatedAt           time.Time  `json:"updated_at" gorm:"column:updated_at;type:timestamptz;not null"`
 	DeletedAt           *time.Time `json:"deleted_at" gorm:"column:deleted_at;type:timestamptz"`
@@ -23,10 +34,27 @@ func (Session) TableName() string {
 	return "live_quiz_session"
 }
 
+// isOpen tells whether an assignment takes answers at the given time.
+func (s *Session) isOpen(t time.Time) bool {
+	if s.Mode != util.AssignmentMode || s.OpensAt == nil || s.ClosesAt == nil {
+		return false
+	}
+	return !t.Before(*s.OpensAt) && t.Before(*s.ClosesAt)
+}
+
 type LiveQuizSession struct {
 	Session
 	Code    string                `json:"code"`
-	Clients map[uuid.UUID]*Client `json:"clients"`
+	Clients map[uuid.UUID]*Client `json:"-"`
+	mailbox chan *envelope
+	done    chan struct{}
+	timer   chan Content
+	phase   sync.Mutex
+	// roles mirrors Cache.Roles so that host controls need not go to Redis.
+	// It is dropped, and rolesGen bumped, whenever a role changes.
+	roles    map[string]string
+	rolesGen int
+	rolesMu  sync.Mutex
 }
 
 type Cache struct {
@@ -46,6 +74,364 @@ type Cache struct {
 	Orders            []int                     `json:"orders"`
 	ResponseCount     int                       `json:"response_count"`
 	ParticipantCount  int                       `json:"participant_count"`
+	Streaks           map[string]int            `json:"streaks"`
+	Exempted          []string                  `json:"exempted"`
+	OpensAt           *time.Time                `json:"opens_at"`
+	StartsAt          *time.Time                `json:"starts_at"`
+	Roles             map[string]string         `json:"roles"`
+	Kicked            []string                  `json:"kicked"`
+	Timer
+}
+
+// Timer is the countdown of the current phase. It is kept under its own key
+// rather than with the rest of the cache, which is read and written back whole
+// by everything else, so that only the countdown ever writes it. Whatever copy
+// ends up in the cache itself is ignored.
+type Timer struct {
+	StartedAt time.Time `json:"started_at"`
+	Deadline  time.Time `json:"deadline"`
+	Paused    bool      `json:"paused"`
+	PausedAt  time.Time `json:"paused_at"`
+}
+
+// trusts tells whether the host handed a signed-in user a role that covers
+// action. Roles are kept by user so that they outlast a reconnect.
+func (c *Cache) trusts(userID *uuid.UUID, action string) bool {
+	return rolesTrust(c.Roles, userID, action)
+}
+
+func rolesTrust(roles map[string]string, userID *uuid.UUID, action string) bool {
+	if userID == nil {
+		return false
+	}
+	return slices.Contains(delegatedControls[roles[userID.String()]], action)
+}
+
+// assists tells whether a signed-in user holds any role, which lets them see
+// what only the host sees, such as how the answers are spread.
+func (c *Cache) assists(userID *uuid.UUID) bool {
+	return rolesAssist(c.Roles, userID)
+}
+
+func rolesAssist(roles map[string]string, userID *uuid.UUID) bool {
+	if userID == nil {
+		return false
+	}
+	_, ok := delegatedControls[roles[userID.String()]]
+	return ok
+}
+
+// scoring sets up the scoring of a question for a participant. Questions in a
+// pool are timed by the pool, hence the separate time limit and factor.
+func (c *Cache) scoring(question any, timeLimit float64, timeFactor float64, pid string) Scoring {
+	selectGrading := ""
+	if q, ok := question.(map[string]any); ok {
+		selectGrading, _ = q["select_grading"].(string)
+	}
+
+	return Scoring{
+		Policy:        c.Config.ScoringConfig.policy(),
+		TimeLimit:     timeLimit,
+		TimeFactor:    timeFactor,
+		Streak:        c.Streaks[pid],
+		SelectGrading: selectGrading,
+		Voided:        c.isExempted(questionID(question)),
+	}
+}
+
+// isExempted tells whether the host voided a question.
+func (c *Cache) isExempted(id string) bool {
+	for _, e := range c.Exempted {
+		if e == id {
+			return true
+		}
+	}
+	return false
+}
+
+// questionID reads the ID of a question in the cache.
+func questionID(question any) string {
+	q, ok := question.(map[string]any)
+	if !ok {
+		return ""
+	}
+	id, _ := q["id"].(string)
+	return id
+}
+
+// voidedIDs returns the IDs to void along with a question: a pool takes its
+// questions with it.
+func voidedIDs(question any) []string {
+	ids := []string{questionID(question)}
+	q, ok := question.(map[string]any)
+	if !ok {
+		return ids
+	}
+	sqs, _ := q["subquestions"].([]any)
+	for _, sq := range sqs {
+		ids = append(ids, questionID(sq))
+	}
+	return ids
+}
+
+// findQuestion looks a question up by ID among the questions of the session
+// and the questions of its pools.
+func (c *Cache) findQuestion(id string) (any, bool) {
+	for _, q := range c.Questions {
+		if questionID(q) == id {
+			return q, true
+		}
+		qm, ok := q.(map[string]any)
+		if !ok {
+			continue
+		}
+		sqs, _ := qm["subquestions"].([]any)
+		for _, sq := range sqs {
+			if questionID(sq) == id {
+				return sq, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// isGraded tells whether the current question is scored. Ungraded questions
+// leave the leaderboard alone.
+func (c *Cache) isGraded() bool {
+	if c.CurrentQuestion < 1 || c.CurrentQuestion > len(c.Orders) {
+		return true
+	}
+	q, ok := c.Questions[c.Orders[c.CurrentQuestion-1]-1].(map[string]any)
+	if !ok {
+		return true
+	}
+	t, _ := q["type"].(string)
+	return util.IsGraded(t)
+}
+
+// showsLeaderboard tells whether participants get to see the leaderboard at
+// this point of the session. The host always does.
+func (c *Cache) showsLeaderboard() bool {
+	switch c.Status {
+	case util.Questioning, util.Answering:
+		return c.Config.LeaderboardConfig.DuringQuestions
+	case util.RevealingAnswer:
+		return c.Config.LeaderboardConfig.AfterQuestions && c.isGraded()
+	}
+	return true
+}
+
+// subquestion returns the i-th question of a pool.
+func subquestion(question any, i int) any {
+	q, ok := question.(map[string]any)
+	if !ok {
+		return nil
+	}
+	sqs, _ := q["subquestions"].([]any)
+	if i < 0 || i >= len(sqs) {
+		return nil
+	}
+	return sqs[i]
+}
+
+// answerGracePeriod leaves room for answers sent right before the deadline
+// that are still in flight when it passes.
+const answerGracePeriod = 500 * time.Millisecond
+
+func (c *Cache) isAnswering() bool {
+	if c.Config.ShuffleConfig.PerParticipant {
+		return c.Status == util.Answering
+	}
+	return c.Status == util.Answering && (c.Paused || time.Now().Before(c.Deadline.Add(answerGracePeriod)))
+}
+
+// elapsed is how long the current countdown has been running, not counting
+// the time it spent paused.
+func (c *Cache) elapsed() time.Duration {
+	end := time.Now()
+	if c.Paused {
+		end = c.PausedAt
+	}
+	return end.Sub(c.StartedAt)
+}
+
+// timeLeft is what remains of the current countdown.
+func (c *Cache) timeLeft() time.Duration {
+	if c.Paused {
+		return c.Deadline.Sub(c.PausedAt)
+	}
+	return time.Until(c.Deadline)
+}
+
+// questionFor returns the current question as the given client should see it.
+// With option shuffling on, every participant gets the options in an order of
+// their own, which stays the same when they come back to the question. The
+// host always sees the canonical order. Answers refer to options by ID, so the
+// order does not matter for scoring.
+func (c *Cache) questionFor(cid uuid.UUID, isHost bool) any {
+	q := c.Questions[c.Orders[c.CurrentQuestion-1]-1]
+	if !c.Config.ShuffleConfig.Option || isHost {
+		return q
+	}
+
+	question, ok := q.(map[string]any)
+	if !ok {
+		return q
+	}
+	return shuffleQuestionOptions(question, cid)
+}
+
+// newProgress draws the question order and the pool questions of a
+// participant. Required pool questions are always drawn.
+func (c *Cache) newProgress() *Progress {
+	count := len(c.Questions)
+	orders := make([]int, count)
+	if c.Config.ShuffleConfig.Question {
+		orders = util.ShuffleNumbers(count)
+	} else {
+		for i := 0; i < count; i++ {
+			orders[i] = i + 1
+		}
+	}
+
+	p := &Progress{
+		Orders:          orders,
+		SubQuestions:    make(map[string][]int),
+		CurrentQuestion: 1,
+		StartedAt:       time.Now(),
+	}
+
+	for _, q := range c.Questions {
+		question, ok := q.(map[string]any)
+		if !ok || question["type"] != util.Pool {
+			continue
+		}
+		qid, _ := question["id"].(string)
+		sqs, _ := question["subquestions"].([]any)
+
+		drawn := make([]int, 0)
+		optional := make([]int, 0)
+		for i, sq := range sqs {
+			if sq, ok := sq.(map[string]any); ok && sq["pool_required"] == true {
+				drawn = append(drawn, i)
+			} else {
+				optional = append(optional, i)
+			}
+		}
+
+		size := c.Config.ShuffleConfig.PoolSize
+		if size <= 0 || size > len(sqs) {
+			size = len(sqs)
+		}
+		for _, n := range util.ShuffleNumbers(len(optional)) {
+			if len(drawn) >= size {
+				break
+			}
+			drawn = append(drawn, optional[n-1])
+		}
+		sort.Ints(drawn)
+
+		p.SubQuestions[qid] = drawn
+	}
+
+	return p
+}
+
+// ownQuestion returns the question a participant is on in per-participant
+// mode, with only the pool questions drawn for them.
+func (c *Cache) ownQuestion(p *Progress, cid uuid.UUID) any {
+	q := c.Questions[p.Orders[p.CurrentQuestion-1]-1]
+	question, ok := q.(map[string]any)
+	if !ok {
+		return q
+	}
+
+	qid, _ := question["id"].(string)
+	if drawn, ok := p.SubQuestions[qid]; ok {
+		sqs, _ := question["subquestions"].([]any)
+		res := make(map[string]any, len(question))
+		for k, v := range question {
+			res[k] = v
+		}
+		subquestions := make([]any, 0, len(drawn))
+		for _, i := range drawn {
+			if i < len(sqs) {
+				subquestions = append(subquestions, sqs[i])
+			}
+		}
+		res["subquestions"] = subquestions
+		question = res
+	}
+
+	if !c.Config.ShuffleConfig.Option {
+		return question
+	}
+	return shuffleQuestionOptions(question, cid)
+}
+
+func shuffleQuestionOptions(question map[string]any, cid uuid.UUID) map[string]any {
+	res := make(map[string]any, len(question))
+	for k, v := range question {
+		res[k] = v
+	}
+
+	qid, _ := question["id"].(string)
+	seed := fnv.New64a()
+	seed.Write([]byte(cid.String() + qid))
+
+	switch question["type"] {
+	case util.Choice, util.TrueFalse, util.Poll, util.Ordering:
+		if options, ok := question["options"].([]any); ok {
+			res["options"] = shuffleOptions(options, int64(seed.Sum64()))
+		}
+	case util.Matching:
+		if options, ok := question["options"].(map[string]any); ok {
+			shuffled := make(map[string]any, len(options))
+			for k, v := range options {
+				shuffled[k] = v
+			}
+			if prompts, ok := options["prompts"].([]any); ok {
+				shuffled["prompts"] = shuffleOptions(prompts, int64(seed.Sum64()))
+			}
+			if opts, ok := options["options"].([]any); ok {
+				seed.Write([]byte("options"))
+				shuffled["options"] = shuffleOptions(opts, int64(seed.Sum64()))
+			}
+			res["options"] = shuffled
+		}
+	case util.Pool:
+		if sqs, ok := question["subquestions"].([]any); ok {
+			shuffled := make([]any, len(sqs))
+			for i, sq := range sqs {
+				shuffled[i] = sq
+				if sq, ok := sq.(map[string]any); ok {
+					shuffled[i] = shuffleQuestionOptions(sq, cid)
+				}
+			}
+			res["subquestions"] = shuffled
+		}
+	}
+
+	return res
+}
+
+// shuffleOptions reorders the options and renumbers their order to match, so
+// that clients sorting by order show the shuffled order.
+func shuffleOptions(options []any, seed int64) []any {
+	res := make([]any, len(options))
+	for i, n := range util.ShuffleNumbersWithSeed(len(options), seed) {
+		res[i] = options[n-1]
+		if o, ok := options[n-1].(map[string]any); ok {
+			shuffled := make(map[string]any, len(o))
+			for k, v := range o {
+				shuffled[k] = v
+			}
+			shuffled["order"] = i + 1
+			res[i] = shuffled
+		}
+	}
+
+	return res
 }
 
 type SessionResponse struct {
@@ -57,11 +443,18 @@ type Configurations struct {
 	ParticipantConfig ParticipantConfigurations `json:"participant"`
 	LeaderboardConfig LeaderboardConfigurations `json:"leaderboard"`
 	OptionConfig      OptionConfigurations      `json:"option"`
+	ScoringConfig     ScoringConfigurations     `json:"scoring"`
+	JoinConfig        JoinConfigurations        `json:"join"`
 }
 
 type ShuffleConfigurations struct {
 	Question bool `json:"question"`
 	Option   bool `json:"option"`
+	// PerParticipant lets every participant go through the quiz on their own,
+	// in an order of their own and with PoolSize questions drawn from each
+	// pool. A PoolSize of 0 keeps every question of the pool.
+	PerParticipant bool `json:"per_participant"`
+	PoolSize       int  `json:"pool_size"`
 }
 
 type ParticipantConfigurations struct {
@@ -73,11 +466,142 @@ type LeaderboardConfigurations struct {
 	AfterQuestions  bool `json:"after"`
 }
 
+type ScoringConfigurations struct {
+	Policy  string  `json:"policy"`
+	Decay   float64 `json:"decay"`
+	Streak  float64 `json:"streak"`
+	Penalty int     `json:"penalty"`
+}
+
+// JoinConfigurations is who may join a session. Approval holds every
+// participant in the waiting room until the host lets them in, Allowlist
+// takes the emails and domains of the registered users who may join, and the
+// Passcode is kept hashed once the session is created.
+type JoinConfigurations struct {
+	Approval  bool     `json:"approval"`
+	Allowlist []string `json:"allowlist"`
+	Passcode  string   `json:"passcode"`
+}
+
 type OptionConfigurations struct {
 	Colorless         bool `json:"colorless"`
 	ShowCorrectAnswer bool `json:"show_correct_answer"`
 }
 
+// poolAnswersByIndex keys the answers to a pool question by the position of
+// their question in the whole pool rather than in the questions drawn.
+func poolAnswersByIndex(question map[string]any, options any) any {
+	answers, ok := options.(map[string]any)
+	if !ok {
+		return options
+	}
+
+	sqs, _ := question["subquestions"].([]any)
+	res := make(map[string]any, len(answers))
+	for k, a := range answers {
+		key := k
+		if a, ok := a.(map[string]any); ok {
+			for i, sq := range sqs {
+				if sq, ok := sq.(map[string]any); ok && sq["id"] == a["qid"] {
+					key = strconv.Itoa(i)
+					break
+				}
+			}
+		}
+		res[key] = a
+	}
+
+	return res
+}
+
+// ---------- Progress related models ---------- //
+type Progress struct {
+	Orders          []int            `json:"orders"`
+	SubQuestions    map[string][]int `json:"subquestions"`
+	CurrentQuestion int              `json:"current_question"`
+	StartedAt       time.Time        `json:"started_at"`
+	// Streak is only kept for assignments, which have no session cache to
+	// keep it in.
+	Streak int `json:"streak"`
+	// saved is the question an assignment participant was on when their
+	// progress was read, so that it is only saved over if it has not moved on
+	// since.
+	saved int
+}
+
+func (p *Progress) isDone() bool {
+	return p.CurrentQuestion > len(p.Orders)
+}
+
+// next moves an assignment participant on to their next question, which is
+// timed from when they first open it.
+func (p *Progress) next() {
+	p.CurrentQuestion++
+	p.StartedAt = time.Time{}
+}
+
+// AssignmentProgress is the progress of a participant through an assignment.
+// Assignments stay open far longer than the session cache lives, so it is kept
+// in the database instead.
+type AssignmentProgress struct {
+	ParticipantID     uuid.UUID `gorm:"column:participant_id;type:uuid;primaryKey"`
+	LiveQuizSessionID uuid.UUID `gorm:"column:live_quiz_session_id;type:uuid;not null"`
+	Progress          string    `gorm:"column:progress;type:text;not null"`
+	CurrentQuestion   int       `gorm:"column:current_question;type:int;not null"`
+	CreatedAt         time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+	UpdatedAt         time.Time `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (AssignmentProgress) TableName() string {
+	return "assignment_progress"
+}
+
+type ProgressPayload struct {
+	ParticipantID   uuid.UUID `json:"participant_id"`
+	CurrentQuestion int       `json:"current_question"`
+	QuestionCount   int       `json:"question_count"`
+}
+
+// ---------- Schedule related models ---------- //
+
+// Schedule is a session set to open its lobby and start on its own. It is kept
+// in the database so that pending schedules survive restarts.
+type Schedule struct {
+	ID        uuid.UUID `gorm:"column:id;type:uuid;primaryKey"`
+	Code      string    `gorm:"column:code;type:text;not null"`
+	OpensAt   time.Time `gorm:"column:opens_at;type:timestamptz;not null"`
+	StartsAt  time.Time `gorm:"column:starts_at;type:timestamptz;not null"`
+	Config    string    `gorm:"column:config;type:text;not null"`
+	Status    string    `gorm:"column:status;type:text;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (Schedule) TableName() string {
+	return "live_quiz_schedule"
+}
+
+// ---------- Join request related models ---------- //
+
+// JoinRequest is a participant waiting in the waiting room for the host to let
+// them in. Once let in, they join with its ID as their participant ID.
+type JoinRequest struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    *uuid.UUID `json:"user_id"`
+	Name      string     `json:"display_name"`
+	Emoji     string     `json:"display_emoji"`
+	Color     string     `json:"display_color"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type CreateJoinRequestRequest struct {
+	Name     string `json:"name"`
+	Emoji    string `json:"emoji"`
+	Color    string `json:"color"`
+	Passcode string `json:"passcode"`
+}
+
 // ---------- Participant related models ---------- //
 type Participant struct {
 	ID                uuid.UUID  `json:"id" gorm:"column:id;type:uuid;primaryKey"`
@@ -106,6 +630,7 @@ type Response struct {
 	Type              string     `json:"type" gorm:"column:type;type:text;not null"`
 	Answer            any        `json:"answer" gorm:"column:answer;type:text;not null"`
 	TimeTaken         int        `json:"time" gorm:"column:use_time;type:int;not null"`
+	Marks             int        `json:"marks" gorm:"column:marks;type:int"`
 	CreatedAt         time.Time  `json:"created_at" gorm:"column:created_at;type:timestamptz;not null"`
 	UpdatedAt         time.Time  `json:"updated_at" gorm:"column:updated_at;type:timestamptz;not null"`
 	DeletedAt         *time.Time `json:"deleted_at" gorm:"column:deleted_at;type:timestamptz"`
@@ -115,9 +640,41 @@ func (Response) TableName() string {
 	return "answer_response"
 }
 
+// ResponseRecord is a saved response read back for regrading.
+type ResponseRecord struct {
+	ParticipantID uuid.UUID `gorm:"column:participant_id"`
+	Answer        string    `gorm:"column:answer"`
+	TimeTaken     int       `gorm:"column:use_time"`
+	Marks         *int      `gorm:"column:marks"`
+}
+
+// RegradeQuestion is a question of a finished session with its corrected
+// answer key, in the same shape as the answers in the session cache. The key
+// it was played with scores responses saved before marks were stored.
+type RegradeQuestion struct {
+	ID            uuid.UUID
+	Type          string
+	TimeLimit     float64
+	TimeFactor    float64
+	SelectGrading string
+	Answers       []any
+	Original      []any
+}
+
+// RegradeChange is how a participant moved when a question was regraded.
+type RegradeChange struct {
+	ParticipantID uuid.UUID `json:"participant_id"`
+	Name          string    `json:"name"`
+	PreviousMarks int       `json:"previous_marks"`
+	Marks         int       `json:"marks"`
+	PreviousRank  int       `json:"previous_rank"`
+	Rank          int       `json:"rank"`
+}
+
 type Repository interface {
 	GetLiveQuizSessionBySessionID(ctx context.Context, id uuid.UUID) (*Session, error)
 	GetLiveQuizSessionsByUserID(ctx context.Context, id uuid.UUID) ([]Session, error)
+	IsLiveQuizSessionRunning(ctx context.Context, id uuid.UUID) (bool, error)
 
 	// ---------- Live Quiz Session related repository methods ---------- //
 	CreateLiveQuizSession(ctx context.Context, lqs *Session) (*Session, error)
@@ -144,9 +701,26 @@ type Repository interface {
 	GetParticipantByLiveQuizSessionIDAndParticipantID(ctx context.Context, lqsID uuid.UUID, pid uuid.UUID) (*Participant, error)
 	DoesParticipantExist(ctx context.Context, id uuid.UUID) (bool, error)
 	UpdateParticipant(ctx context.Context, participant *Participant) (*Participant, error)
+	UpdateParticipantProfile(ctx context.Context, participant *Participant) error
+	UpdateParticipantStatus(ctx context.Context, id uuid.UUID, status string) error
+	AddParticipantMarks(ctx context.Context, id uuid.UUID, marks int) error
 
 	// ---------- Response related repository methods ---------- //
 	CreateResponse(ctx context.Context, ansRes *Response) (*Response, error)
+	GetResponsesByLiveQuizSessionIDAndQuestionID(ctx context.Context, lqsID uuid.UUID, qid uuid.UUID) ([]ResponseRecord, error)
+	GetResponseMarks(ctx context.Context, lqsID uuid.UUID, pid uuid.UUID, qid uuid.UUID) (int, error)
+	UpdateResponseMarks(ctx context.Context, lqsID uuid.UUID, pid uuid.UUID, qid uuid.UUID, marks int) error
+
+	// ---------- Schedule related repository methods ---------- //
+	CreateLiveQuizSchedule(ctx context.Context, schedule *Schedule) (*Schedule, error)
+	GetLiveQuizSchedulesByStatus(ctx context.Context, statuses []string) ([]Schedule, error)
+	UpdateLiveQuizScheduleStatus(ctx context.Context, id uuid.UUID, from []string, to string) (bool, error)
+
+	// ---------- Assignment related repository methods ---------- //
+	GetAssignmentProgress(ctx context.Context, pid uuid.UUID) (*AssignmentProgress, error)
+	SaveAssignmentProgress(ctx context.Context, ap *AssignmentProgress) (*AssignmentProgress, error)
+	UpdateAssignmentProgress(ctx context.Context, ap *AssignmentProgress, from int) (bool, error)
+	Transaction(ctx context.Context, fn func(Repository) error) error
 	// Choice response related repository methods
 	// CreateChoiceResponse(ctx context.Context, r *ChoiceResponse) (*ChoiceResponse, error)
 	// GetChoiceResponsesByParticipantID(ctx context.Context, participantID uuid.UUID) ([]ChoiceResponse, error)
@@ -164,13 +738,65 @@ type LiveQuizSessionResponse struct {
 	Status string    `json:"status"`
 }
 type CreateLiveQuizSessionRequest struct {
-	QuizID uuid.UUID      `json:"quiz_id"`
-	Config Configurations `json:"config"`
+	QuizID   uuid.UUID        `json:"quiz_id"`
+	Config   Configurations   `json:"config"`
+	Schedule *ScheduleRequest `json:"schedule"`
 }
 type CreateLiveQuizSessionResponse struct {
-	ID     uuid.UUID `json:"id"`
-	QuizID uuid.UUID `json:"quiz_id"`
-	Code   string    `json:"code"`
+	ID       uuid.UUID  `json:"id"`
+	QuizID   uuid.UUID  `json:"quiz_id"`
+	Code     string     `json:"code"`
+	OpensAt  *time.Time `json:"opens_at"`
+	StartsAt *time.Time `json:"starts_at"`
+}
+
+// ScheduleRequest sets a session to start on its own. The lobby opens
+// scheduleLobbyLead before the start unless told otherwise.
+type ScheduleRequest struct {
+	OpensAt  *time.Time `json:"opens_at"`
+	StartsAt time.Time  `json:"starts_at"`
+}
+
+func (r *ScheduleRequest) opensAt() time.Time {
+	if r.OpensAt != nil {
+		return *r.OpensAt
+	}
+	return r.StartsAt.Add(-scheduleLobbyLead)
+}
+
+type CreateAssignmentRequest struct {
+	QuizID   uuid.UUID      `json:"quiz_id"`
+	OpensAt  time.Time      `json:"opens_at"`
+	ClosesAt time.Time      `json:"closes_at"`
+	Config   Configurations `json:"config"`
+}
+type AssignmentResponse struct {
+	ID            uuid.UUID `json:"id"`
+	QuizID        uuid.UUID `json:"quiz_id"`
+	QuizTitle     string    `json:"quiz_title"`
+	QuestionCount int       `json:"question_count"`
+	OpensAt       time.Time `json:"opens_at"`
+	ClosesAt      time.Time `json:"closes_at"`
+}
+type JoinAssignmentRequest struct {
+	Name  string `json:"name"`
+	Emoji string `json:"emoji"`
+	Color string `json:"color"`
+}
+type JoinAssignmentResponse struct {
+	Participant Participant     `json:"participant"`
+	Progress    ProgressPayload `json:"progress"`
+	Token       string          `json:"token"`
+}
+type AssignmentQuestionResponse struct {
+	Progress ProgressPayload `json:"progress"`
+	Question any             `json:"question"`
+	Deadline *time.Time      `json:"deadline"`
+}
+type AssignmentAnswerResponse struct {
+	Answers  any             `json:"answers"`
+	Marks    int             `json:"marks"`
+	Progress ProgressPayload `json:"progress"`
 }
 type UpdateLiveQuizSessionRequest struct {
 	Status          string  `json:"status"`
@@ -178,31 +804,46 @@ type UpdateLiveQuizSessionRequest struct {
 }
 
 type JoinedMessage struct {
-	Code    string    `json:"code"`
-	ID      uuid.UUID `json:"id"`
-	Name    string    `json:"name"`
-	Emoji   string    `json:"emoji"`
-	Color   string    `json:"color"`
-	IsHost  bool      `json:"is_host"`
-	Answers any       `json:"answers"`
-	Marks   int       `json:"marks"`
-	Rank    int       `json:"rank"`
+	Code        string    `json:"code"`
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Emoji       string    `json:"emoji"`
+	Color       string    `json:"color"`
+	IsHost      bool      `json:"is_host"`
+	IsSpectator bool      `json:"is_spectator"`
+	Answers     any       `json:"answers"`
+	Marks       int       `json:"marks"`
+	Rank        int       `json:"rank"`
+	Resume      *Resume   `json:"resume"`
+}
+
+// Resume is sent along with the join message of a client that reconnects with
+// the sequence number of the last message it saw.
+type Resume struct {
+	Messages  []Message `json:"messages"`
+	Complete  bool      `json:"complete"`
+	Question  any       `json:"question"`
+	Countdown *Message  `json:"countdown"`
 }
 
 type CheckLiveQuizSessionAvailabilityResponse struct {
-	ID              uuid.UUID `json:"id"`
-	QuizID          uuid.UUID `json:"quiz_id"`
-	QuizTitle       string    `json:"quiz_title"`
-	Code            string    `json:"code"`
-	QuestionCount   int       `json:"question_count"`
-	CurrentQuestion int       `json:"current_question"`
-	Status          string    `json:"status"`
+	ID              uuid.UUID  `json:"id"`
+	QuizID          uuid.UUID  `json:"quiz_id"`
+	QuizTitle       string     `json:"quiz_title"`
+	Code            string     `json:"code"`
+	QuestionCount   int        `json:"question_count"`
+	CurrentQuestion int        `json:"current_question"`
+	Status          string     `json:"status"`
+	OpensAt         *time.Time `json:"opens_at"`
+	StartsAt        *time.Time `json:"starts_at"`
 }
 
 type CountDownPayload struct {
-	TimeLeft        float64 `json:"time_left"`
-	CurrentQuestion int     `json:"current_question"`
-	Status          string  `json:"status"`
+	TimeLeft        float64   `json:"time_left"`
+	Deadline        time.Time `json:"deadline"`
+	Paused          bool      `json:"paused"`
+	CurrentQuestion int       `json:"current_question"`
+	Status          string    `json:"status"`
 }
 
 type ByMarks []Participant
@@ -229,6 +870,40 @@ type ChoiceAnswerResponse struct {
 	Marks   *int           `json:"marks"`
 	Time    int            `json:"time"`
 }
+type PollAnswerResponse struct {
+	Answers []ChoiceAnswer `json:"answers"`
+	Time    int            `json:"time"`
+}
+
+// PollResult is how many votes an option of a poll got, and their share of
+// all votes in percent.
+type PollResult struct {
+	ID         string  `json:"id"`
+	Content    string  `json:"content"`
+	Color      string  `json:"color"`
+	Count      int     `json:"count"`
+	Percentage float64 `json:"percentage"`
+}
+type PollHostResponse struct {
+	Results []PollResult `json:"results"`
+	Total   int          `json:"total"`
+}
+type WordCloudAnswerResponse struct {
+	Answers []string `json:"answers"`
+	Time    int      `json:"time"`
+}
+
+// WordFrequency is how often a word was entered, with Frequency relative to
+// the most entered word.
+type WordFrequency struct {
+	Word      string  `json:"word"`
+	Count     int     `json:"count"`
+	Frequency float64 `json:"frequency"`
+}
+type WordCloudHostResponse struct {
+	Words []WordFrequency `json:"words"`
+	Total int             `json:"total"`
+}
 type TextAnswer struct {
 	ID            string `json:"id"`
 	CaseSensitive bool   `json:"caseSensitive"`
@@ -247,6 +922,100 @@ type ParagraphAnswerResponse struct {
 	Marks  *int   `json:"marks"`
 	Time   int    `json:"time"`
 }
+type NumericAnswer struct {
+	ID        string  `json:"id"`
+	Value     float64 `json:"value"`
+	Tolerance float64 `json:"tolerance"`
+	MinValue  float64 `json:"min_value"`
+	MaxValue  float64 `json:"max_value"`
+	Unit      string  `json:"unit"`
+	Mark      int     `json:"mark"`
+}
+type NumericAnswerResponse struct {
+	Answers []NumericAnswer `json:"answers"`
+	Answer  string          `json:"answer"`
+	Correct bool            `json:"correct"`
+	Marks   *int            `json:"marks"`
+	Time    int             `json:"time"`
+}
+
+// NumericBucket is one bar of the histogram of submitted values shown to the
+// host once a numeric question is revealed.
+type NumericBucket struct {
+	Value float64 `json:"value"`
+	Count int     `json:"count"`
+}
+type NumericHostResponse struct {
+	Answers   []NumericAnswer `json:"answers"`
+	Histogram []NumericBucket `json:"histogram"`
+}
+type SliderAnswer struct {
+	ID        string  `json:"id"`
+	MinValue  float64 `json:"min_value"`
+	MaxValue  float64 `json:"max_value"`
+	Step      float64 `json:"step"`
+	Target    float64 `json:"target"`
+	Tolerance float64 `json:"tolerance"`
+	Falloff   float64 `json:"falloff"`
+	Mark      int     `json:"mark"`
+}
+type SliderAnswerResponse struct {
+	Answers   []SliderAnswer `json:"answers"`
+	Answer    *float64       `json:"answer"`
+	Closeness float64        `json:"closeness"`
+	Correct   bool           `json:"correct"`
+	Marks     *int           `json:"marks"`
+	Time      int            `json:"time"`
+}
+type SliderHostResponse struct {
+	Answers   []SliderAnswer  `json:"answers"`
+	Histogram []NumericBucket `json:"histogram"`
+}
+type HotspotAnswer struct {
+	ID     string       `json:"id"`
+	Shape  string       `json:"shape"`
+	X      float64      `json:"x"`
+	Y      float64      `json:"y"`
+	Width  float64      `json:"width"`
+	Height float64      `json:"height"`
+	Radius float64      `json:"radius"`
+	Points []util.Point `json:"points"`
+	Mark   int          `json:"mark"`
+}
+type HotspotAnswerResponse struct {
+	Answers []HotspotAnswer `json:"answers"`
+	Click   *util.Point     `json:"click"`
+	Correct bool            `json:"correct"`
+	Marks   *int            `json:"marks"`
+	Time    int             `json:"time"`
+}
+
+// HotspotClick is a spot on the question image and how many participants
+// clicked it, for the host to draw a heatmap from.
+type HotspotClick struct {
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Count int     `json:"count"`
+}
+type HotspotHostResponse struct {
+	Answers []HotspotAnswer `json:"answers"`
+	Clicks  []HotspotClick  `json:"clicks"`
+}
+type OrderingAnswer struct {
+	ID       string `json:"id"`
+	Content  string `json:"content"`
+	Color    string `json:"color"`
+	Order    int    `json:"order"`
+	Position int    `json:"position"`
+	Correct  bool   `json:"correct"`
+	Mark     int    `json:"mark"`
+}
+type OrderingAnswerResponse struct {
+	Answers []OrderingAnswer `json:"answers"`
+	Correct bool             `json:"correct"`
+	Marks   *int             `json:"marks"`
+	Time    int              `json:"time"`
+}
 type MatchingAnswer struct {
 	PromptID string `json:"prompt"`
 	OptionID string `json:"option"`
@@ -270,6 +1039,14 @@ type PoolAnswerResponse struct {
 	Time    int                   `json:"time"`
 }
 
+// MarksOverride is what the host sends to set the marks a participant got for
+// a question.
+type MarksOverride struct {
+	ParticipantID uuid.UUID `json:"participant_id"`
+	QuestionID    uuid.UUID `json:"question_id"`
+	Marks         int       `json:"marks"`
+}
+
 type AnswerPayload struct {
 	Answers       any       `json:"answers"`
 	ParticipantID uuid.UUID `json:"participant_id"`
@@ -281,7 +1058,7 @@ type Service interface {
 	GetLiveQuizSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]SessionResponse, error)
 
 	// ---------- Live Quiz Session related service methods ---------- //
-	CreateLiveQuizSession(ctx context.Context, quizID uuid.UUID, id uuid.UUID, code string, hostID uuid.UUID) (*CreateLiveQuizSessionResponse, error)
+	CreateLiveQuizSession(ctx context.Context, quizID uuid.UUID, id uuid.UUID, code string, hostID uuid.UUID, scoring ScoringConfigurations) (*CreateLiveQuizSessionResponse, error)
 	GetLiveQuizSessions(ctx context.Context, hub *Hub) ([]LiveQuizSessionResponse, error)
 	GetLiveQuizSessionByID(ctx context.Context, id uuid.UUID) (*LiveQuizSessionResponse, error)
 	GetLiveQuizSessionByQuizID(ctx context.Context, quizID uuid.UUID) (*LiveQuizSessionResponse, error)
@@ -291,6 +1068,7 @@ type Service interface {
 	CreateLiveQuizSessionCache(ctx context.Context, code string, cache *Cache) error
 	GetLiveQuizSessionCache(ctx context.Context, code string) (*Cache, error)
 	UpdateLiveQuizSessionCache(ctx context.Context, code string, cache *Cache) error
+	UpdateLiveQuizSessionTimer(ctx context.Context, code string, timer *Timer) error
 	FlushLiveQuizSessionCache(ctx context.Context, code string) error
 	DoesLiveQuizSessionCacheExist(ctx context.Context, code string) (bool, error)
 
@@ -302,6 +1080,8 @@ type Service interface {
 	GetParticipantsByLiveQuizSessionID(ctx context.Context, lqsID uuid.UUID) ([]Participant, error)
 	DoesParticipantExist(ctx context.Context, id uuid.UUID) (bool, error)
 	UpdateParticipant(ctx context.Context, p *Participant) (*Participant, error)
+	UpdateParticipantProfile(ctx context.Context, p *Participant) error
+	UpdateParticipantStatus(ctx context.Context, id uuid.UUID, status string) error
 
 	// ---------- Response related service methods ---------- //
 	CreateResponse(ctx context.Context, code string, qid string, pid string, response any) error
@@ -312,17 +1092,54 @@ type Service interface {
 	DoesResponseExist(ctx context.Context, code string, qid string, pid string) (bool, error)
 	CountResponses(ctx context.Context, code string, qid string) (int, error)
 	SaveResponse(ctx context.Context, response *Response) (*Response, error)
+	OverrideMarks(ctx context.Context, lqsID uuid.UUID, req *MarksOverride) (*Participant, error)
+	RegradeQuestion(ctx context.Context, lqsID uuid.UUID, q *RegradeQuestion) ([]RegradeChange, error)
+	VoidQuestions(ctx context.Context, lqsID uuid.UUID, ids []string) ([]Participant, error)
+
+	// ---------- Progress related service methods ---------- //
+	GetProgress(ctx context.Context, code string, pid string) (*Progress, error)
+	UpdateProgress(ctx context.Context, code string, pid string, progress *Progress) error
+
+	// ---------- Schedule related service methods ---------- //
+	CreateLiveQuizSchedule(ctx context.Context, id uuid.UUID, code string, opensAt time.Time, startsAt time.Time, config Configurations) error
+	GetPendingLiveQuizSchedules(ctx context.Context) ([]Schedule, error)
+	ClaimLiveQuizSchedule(ctx context.Context, id uuid.UUID, from []string, to string) (bool, error)
+
+	// ---------- Join request related service methods ---------- //
+	CreateJoinRequest(ctx context.Context, code string, req *JoinRequest) error
+	GetJoinRequest(ctx context.Context, code string, id string) (*JoinRequest, error)
+	GetJoinRequests(ctx context.Context, code string) ([]JoinRequest, error)
+	UpdateJoinRequest(ctx context.Context, code string, req *JoinRequest) error
+	GetUserEmail(ctx context.Context, id uuid.UUID) (string, error)
+
+	// ---------- Assignment related service methods ---------- //
+	CreateAssignment(ctx context.Context, quizID uuid.UUID, hostID uuid.UUID, opensAt time.Time, closesAt time.Time, config Configurations) (*Session, error)
+	GetAssignmentProgress(ctx context.Context, pid uuid.UUID) (*Progress, error)
+	SaveAssignmentProgress(ctx context.Context, lqsID uuid.UUID, pid uuid.UUID, progress *Progress) error
+	AnswerAssignment(ctx context.Context, lqsID uuid.UUID, pid uuid.UUID, progress *Progress, grade func(Service) error) error
 
 	// ---------- Calculation related service methods ---------- //
 	GetAnswersResponseForHost(ctx context.Context, qid string, qType string, answers []any, answerCounts map[string]map[string]int) (any, error)
-	CalculateChoice(ctx context.Context, status string, options []any, answers []any, time float64, timeLimit float64, timeFactor float64) (ChoiceAnswerResponse, error)
-	CalculateAndSaveChoiceResponse(ctx context.Context, options []any, answers []any, answerCounts map[string]int, time float64, timeLimit float64, timeFactor float64, response *Response) (ChoiceAnswerResponse, map[string]int, error)
-	CalculateFillBlank(ctx context.Context, status string, options []any, answers []any, time float64, timeLimit float64, timeFactor float64) (TextAnswerResponse, error)
-	CalculateAndSaveFillBlankResponse(ctx context.Context, options []any, answers []any, time float64, timeLimit float64, timeFactor float64, response *Response) (TextAnswerResponse, error)
-	CalculateParagraph(ctx context.Context, status string, content string, answers []any, time float64, timeLimit float64, timeFactor float64) (any, error)
-	CalculateAndSaveParagraphResponse(ctx context.Context, content string, answers []any, time float64, timeLimit float64, timeFactor float64, response *Response) (any, error)
-	CalculateMatching(ctx context.Context, status string, options []any, answers []any, time float64, timeLimit float64, timeFactor float64) (MatchingAnswerResponse, error)
-	CalculateAndSaveMatchingResponse(ctx context.Context, options []any, answers []any, time float64, timeLimit float64, timeFactor float64, response *Response) (MatchingAnswerResponse, error)
+	CalculateChoice(ctx context.Context, status string, options []any, answers []any, time float64, scoring Scoring) (ChoiceAnswerResponse, error)
+	CalculateAndSaveChoiceResponse(ctx context.Context, options []any, answers []any, answerCounts map[string]int, time float64, scoring Scoring, response *Response) (ChoiceAnswerResponse, map[string]int, error)
+	CalculatePoll(ctx context.Context, options []any, answers []any, time float64) (PollAnswerResponse, error)
+	SavePollResponse(ctx context.Context, options []any, answers []any, answerCounts map[string]int, time float64, response *Response) (PollAnswerResponse, map[string]int, error)
+	CalculateWordCloud(ctx context.Context, words []string, time float64) (WordCloudAnswerResponse, error)
+	SaveWordCloudResponse(ctx context.Context, words []string, answerCounts map[string]int, time float64, response *Response) (WordCloudAnswerResponse, map[string]int, error)
+	CalculateFillBlank(ctx context.Context, status string, options []any, answers []any, time float64, scoring Scoring) (TextAnswerResponse, error)
+	CalculateAndSaveFillBlankResponse(ctx context.Context, options []any, answers []any, time float64, scoring Scoring, response *Response) (TextAnswerResponse, error)
+	CalculateParagraph(ctx context.Context, status string, content string, answers []any, time float64, scoring Scoring) (any, error)
+	CalculateAndSaveParagraphResponse(ctx context.Context, content string, answers []any, time float64, scoring Scoring, response *Response) (any, error)
+	CalculateNumeric(ctx context.Context, status string, content string, answers []any, time float64, scoring Scoring) (NumericAnswerResponse, error)
+	CalculateAndSaveNumericResponse(ctx context.Context, content string, answers []any, answerCounts map[string]int, time float64, scoring Scoring, response *Response) (NumericAnswerResponse, map[string]int, error)
+	CalculateSlider(ctx context.Context, status string, value *float64, answers []any, time float64, scoring Scoring) (SliderAnswerResponse, error)
+	CalculateAndSaveSliderResponse(ctx context.Context, value *float64, answers []any, answerCounts map[string]int, time float64, scoring Scoring, response *Response) (SliderAnswerResponse, map[string]int, error)
+	CalculateHotspot(ctx context.Context, status string, click *util.Point, answers []any, time float64, scoring Scoring) (HotspotAnswerResponse, error)
+	CalculateAndSaveHotspotResponse(ctx context.Context, click *util.Point, answers []any, answerCounts map[string]int, time float64, scoring Scoring, response *Response) (HotspotAnswerResponse, map[string]int, error)
+	CalculateOrdering(ctx context.Context, status string, options []string, answers []any, time float64, scoring Scoring) (OrderingAnswerResponse, error)
+	CalculateAndSaveOrderingResponse(ctx context.Context, options []string, answers []any, time float64, scoring Scoring, response *Response) (OrderingAnswerResponse, error)
+	CalculateMatching(ctx context.Context, status string, options []any, answers []any, time float64, scoring Scoring) (MatchingAnswerResponse, error)
+	CalculateAndSaveMatchingResponse(ctx context.Context, options []any, answers []any, time float64, scoring Scoring, response *Response) (MatchingAnswerResponse, error)
 
 	// ---------- Leaderboard related service methods ---------- //
 	GetLeaderboard(ctx context.Context, lqsID uuid.UUID) ([]Participant, error)
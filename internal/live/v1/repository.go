@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repository struct {
@@ -100,6 +101,12 @@ func (r *repository) UpdateLiveQuizSession(ctx context.Context, lqs *Session, id
 	return lqs, nil
 }
 
+// IsLiveQuizSessionRunning tells whether a session is still registered with
+// the hubs, i.e. has not been ended by its host.
+func (r *repository) IsLiveQuizSessionRunning(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.cache.HExists(ctx, hubSessionsKey, id.String()).Result()
+}
+
 func (r *repository) EndLiveQuizSession(ctx context.Context, id uuid.UUID) error {
 	res := r.db.WithContext(ctx).Model(&LiveQuizSession{}).Where("id = ?", id).Update("status", util.Ended)
 	if res.Error != nil {
@@ -275,6 +282,39 @@ func (r *repository) UpdateParticipant(ctx context.Context, p *Participant) (*Pa
 	return p, nil
 }
 
+// UpdateParticipantProfile only writes what a participant shows to others and
+// whether they are in, leaving their marks to whoever grades them.
+func (r *repository) UpdateParticipantProfile(ctx context.Context, p *Participant) error {
+	res := r.db.WithContext(ctx).Model(p).Select("name", "emoji", "color", "status", "updated_at").Updates(p)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	return nil
+}
+
+// UpdateParticipantStatus only writes whether a participant is in, for
+// callers that may hold marks that have moved on since they were read.
+func (r *repository) UpdateParticipantStatus(ctx context.Context, id uuid.UUID, status string) error {
+	res := r.db.WithContext(ctx).Model(&Participant{}).Where("id = ?", id).Update("status", status)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	return nil
+}
+
+// AddParticipantMarks adds to the marks of a participant in the database, so
+// that answers saved at the same time do not overwrite each other.
+func (r *repository) AddParticipantMarks(ctx context.Context, id uuid.UUID, marks int) error {
+	res := r.db.WithContext(ctx).Model(&Participant{}).Where("id = ?", id).Update("marks", gorm.Expr("marks + ?", marks))
+	if res.Error != nil {
+		return res.Error
+	}
+
+	return nil
+}
+
 // ---------- Response related repository methods ---------- //
 func (r *repository) CreateResponse(ctx context.Context, ansRes *Response) (*Response, error) {
 	res := r.db.WithContext(ctx).Create(ansRes)
@@ -284,3 +324,114 @@ func (r *repository) CreateResponse(ctx context.Context, ansRes *Response) (*Res
 
 	return ansRes, nil
 }
+
+func (r *repository) GetResponsesByLiveQuizSessionIDAndQuestionID(ctx context.Context, lqsID uuid.UUID, qid uuid.UUID) ([]ResponseRecord, error) {
+	var responses []ResponseRecord
+	res := r.db.WithContext(ctx).Model(&Response{}).Where("live_quiz_session_id = ? AND question_id = ?", lqsID, qid).Find(&responses)
+	if res.Error != nil {
+		return []ResponseRecord{}, res.Error
+	}
+
+	return responses, nil
+}
+
+// GetResponseMarks locks the response until the end of the transaction, so
+// that its marks can be moved by the difference to them.
+func (r *repository) GetResponseMarks(ctx context.Context, lqsID uuid.UUID, pid uuid.UUID, qid uuid.UUID) (int, error) {
+	var marks []int
+	res := r.db.WithContext(ctx).Model(&Response{}).Clauses(clause.Locking{Strength: "UPDATE"}).Where("live_quiz_session_id = ? AND participant_id = ? AND question_id = ?", lqsID, pid, qid).Pluck("marks", &marks)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	if len(marks) == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	return marks[0], nil
+}
+
+func (r *repository) UpdateResponseMarks(ctx context.Context, lqsID uuid.UUID, pid uuid.UUID, qid uuid.UUID, marks int) error {
+	res := r.db.WithContext(ctx).Model(&Response{}).Where("live_quiz_session_id = ? AND participant_id = ? AND question_id = ?", lqsID, pid, qid).Update("marks", marks)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	return nil
+}
+
+// ---------- Schedule related repository methods ---------- //
+func (r *repository) CreateLiveQuizSchedule(ctx context.Context, schedule *Schedule) (*Schedule, error) {
+	res := r.db.WithContext(ctx).Create(schedule)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return schedule, nil
+}
+
+func (r *repository) GetLiveQuizSchedulesByStatus(ctx context.Context, statuses []string) ([]Schedule, error) {
+	schedules := make([]Schedule, 0)
+	res := r.db.WithContext(ctx).Where("status IN ?", statuses).Order("starts_at").Find(&schedules)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return schedules, nil
+}
+
+// UpdateLiveQuizScheduleStatus only moves a schedule on from one of the given
+// statuses, and tells whether it did, so that only one replica acts on it.
+func (r *repository) UpdateLiveQuizScheduleStatus(ctx context.Context, id uuid.UUID, from []string, to string) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&Schedule{}).Where("id = ? AND status IN ?", id, from).Update("status", to)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// ---------- Assignment related repository methods ---------- //
+func (r *repository) GetAssignmentProgress(ctx context.Context, pid uuid.UUID) (*AssignmentProgress, error) {
+	var ap AssignmentProgress
+	res := r.db.WithContext(ctx).Where("participant_id = ?", pid).First(&ap)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return &ap, nil
+}
+
+func (r *repository) SaveAssignmentProgress(ctx context.Context, ap *AssignmentProgress) (*AssignmentProgress, error) {
+	res := r.db.WithContext(ctx).Save(ap)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	return ap, nil
+}
+
+// UpdateAssignmentProgress only saves the progress if the participant is still
+// on the question they were on when it was read, and tells whether it did.
+func (r *repository) UpdateAssignmentProgress(ctx context.Context, ap *AssignmentProgress, from int) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&AssignmentProgress{}).Where("participant_id = ? AND current_question = ?", ap.ParticipantID, from).Updates(map[string]any{
+		"progress":         ap.Progress,
+		"current_question": ap.CurrentQuestion,
+		"updated_at":       time.Now(),
+	})
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// Transaction runs fn with a repository that works in one transaction, which
+// is rolled back if fn fails.
+func (r *repository) Transaction(ctx context.Context, fn func(Repository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&repository{
+			db:    tx,
+			cache: r.cache,
+		})
+	})
+}
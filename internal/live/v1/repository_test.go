@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -151,7 +152,7 @@ func TestCreateLiveQuizSession(t *testing.T) {
 	expectedSQL := "INSERT INTO \"live_quiz_session\" (.+) VALUES (.+)"
 	mock.ExpectBegin()
 	mock.ExpectExec(expectedSQL).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -228,3 +229,352 @@ func TestGetLiveQuizSessions(t *testing.T) {
 }
 
 // Test Live Quiz Session 
+
+func TestGetResponseMarks(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	lqsID, pid, qid := uuid.New(), uuid.New(), uuid.New()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"marks"}).
+		AddRow(3)
+
+	// Expected Query
+	expectedSQL := "SELECT \"marks\" FROM \"answer_response\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(lqsID, pid, qid).
+		WillReturnRows(sample)
+
+	// Actual Function
+	res, err := repo.GetResponseMarks(context.TODO(), lqsID, pid, qid)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, 3, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateParticipantProfile(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	data := &Participant{
+		ID:     uuid.New(),
+		Status: "JOINED",
+		Name:   "Name",
+		Emoji:  "Emoji",
+		Color:  "Color",
+		Marks:  100,
+	}
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"participant\" SET \"status\"=\\$1,\"name\"=\\$2,\"emoji\"=\\$3,\"color\"=\\$4,\"updated_at\"=\\$5 WHERE .+").
+		WithArgs(data.Status, data.Name, data.Emoji, data.Color, sqlmock.AnyArg(), data.ID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.UpdateParticipantProfile(context.TODO(), data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateParticipantStatus(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	id := uuid.New()
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"participant\" SET \"status\"=\\$1,\"updated_at\"=\\$2 WHERE .+").
+		WithArgs("LEFT", sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.UpdateParticipantStatus(context.TODO(), id, "LEFT")
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestAddParticipantMarks(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	id := uuid.New()
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"participant\" SET \"marks\"=marks \\+ .+").
+		WithArgs(5, sqlmock.AnyArg(), id).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.AddParticipantMarks(context.TODO(), id, 5)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateResponseMarks(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	lqsID, pid, qid := uuid.New(), uuid.New(), uuid.New()
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"answer_response\" SET .+").
+		WithArgs(5, sqlmock.AnyArg(), lqsID, pid, qid).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.UpdateResponseMarks(context.TODO(), lqsID, pid, qid, 5)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetResponsesByLiveQuizSessionIDAndQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	lqsID, qid := uuid.New(), uuid.New()
+	marks := 7
+	data := &ResponseRecord{
+		ParticipantID: uuid.New(),
+		Answer:        uuid.New().String(),
+		TimeTaken:     42,
+		Marks:         &marks,
+	}
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"participant_id", "answer", "use_time", "marks"}).
+		AddRow(data.ParticipantID.String(), data.Answer, data.TimeTaken, *data.Marks)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"answer_response\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(lqsID, qid).
+		WillReturnRows(sample)
+
+	// Actual Function
+	res, err := repo.GetResponsesByLiveQuizSessionIDAndQuestionID(context.TODO(), lqsID, qid)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []ResponseRecord{*data}, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAssignmentProgress(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	data := &AssignmentProgress{
+		ParticipantID:     uuid.New(),
+		LiveQuizSessionID: uuid.New(),
+		Progress:          `{"orders":[2,1],"current_question":1}`,
+	}
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"participant_id", "live_quiz_session_id", "progress"}).
+		AddRow(data.ParticipantID.String(), data.LiveQuizSessionID.String(), data.Progress)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"assignment_progress\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ParticipantID).
+		WillReturnRows(sample)
+
+	// Actual Function
+	res, err := repo.GetAssignmentProgress(context.TODO(), data.ParticipantID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, data, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveAssignmentProgress(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	data := &AssignmentProgress{
+		ParticipantID:     uuid.New(),
+		LiveQuizSessionID: uuid.New(),
+		Progress:          `{"orders":[2,1],"current_question":2}`,
+		CurrentQuestion:   2,
+	}
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"assignment_progress\" SET .+").
+		WithArgs(data.LiveQuizSessionID, data.Progress, data.CurrentQuestion, sqlmock.AnyArg(), sqlmock.AnyArg(), data.ParticipantID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.SaveAssignmentProgress(context.TODO(), data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, data, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateAssignmentProgress(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	data := &AssignmentProgress{
+		ParticipantID:     uuid.New(),
+		LiveQuizSessionID: uuid.New(),
+		Progress:          `{"orders":[2,1],"current_question":2}`,
+		CurrentQuestion:   2,
+	}
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"assignment_progress\" SET .+ WHERE participant_id = .+ AND current_question = .+").
+		WithArgs(data.CurrentQuestion, data.Progress, sqlmock.AnyArg(), data.ParticipantID, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// Actual Function
+	saved, err := repo.UpdateAssignmentProgress(context.TODO(), data, 1)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.False(t, saved)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateLiveQuizSchedule(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	data := &Schedule{
+		ID:       uuid.New(),
+		Code:     "123456",
+		OpensAt:  time.Now().Add(time.Hour),
+		StartsAt: time.Now().Add(2 * time.Hour),
+		Config:   "{}",
+		Status:   "PENDING",
+	}
+
+	// ===== CREATE  =====
+	expectedSQL := "INSERT INTO \"live_quiz_schedule\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(data.ID, data.Code, data.OpensAt, data.StartsAt, data.Config, data.Status, sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateLiveQuizSchedule(context.TODO(), data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, data, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetLiveQuizSchedulesByStatus(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	data := &Schedule{
+		ID:     uuid.New(),
+		Code:   "123456",
+		Config: "{}",
+		Status: "OPEN",
+	}
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "code", "config", "status"}).
+		AddRow(data.ID.String(), data.Code, data.Config, data.Status)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"live_quiz_schedule\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs("PENDING", "OPEN").
+		WillReturnRows(sample)
+
+	// Actual Function
+	res, err := repo.GetLiveQuizSchedulesByStatus(context.TODO(), []string{"PENDING", "OPEN"})
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.Equal(t, []Schedule{*data}, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateLiveQuizScheduleStatus(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewTestRepository(db)
+
+	// Mock Data
+	id := uuid.New()
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"live_quiz_schedule\" SET .+").
+		WithArgs("STARTED", sqlmock.AnyArg(), id, "OPEN").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// Actual Function
+	claimed, err := repo.UpdateLiveQuizScheduleStatus(context.TODO(), id, []string{"OPEN"}, "STARTED")
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Live-Quiz-Project/Backend/internal/util"
+	"github.com/google/uuid"
+)
+
+const (
+	// scheduleLobbyLead is how long before a scheduled start the lobby opens
+	// unless the host says otherwise.
+	scheduleLobbyLead = 5 * time.Minute
+	// scheduleInterval is how often the pending schedules are looked at.
+	scheduleInterval = time.Second
+)
+
+// RunScheduler opens and starts scheduled sessions when their time comes. The
+// schedules are read from the database on every pass so that a restart picks
+// up where it left off, and every step is claimed there first so that only one
+// replica takes it.
+func (h *Handler) RunScheduler(ctx context.Context) {
+	ticker := time.NewTicker(scheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.runSchedules(ctx)
+		}
+	}
+}
+
+func (h *Handler) runSchedules(ctx context.Context) {
+	schedules, err := h.Service.GetPendingLiveQuizSchedules(ctx)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for i := range schedules {
+		s := &schedules[i]
+		// Every pending schedule keeps its code reserved, not only the ones
+		// that are due, so that participants can check in ahead of time.
+		lqs, err := h.reserveSchedule(ctx, s)
+		if err != nil {
+			log.Printf("Error occured while reserving schedule %v: %v", s.ID, err)
+			continue
+		}
+
+		if !now.Before(s.StartsAt) {
+			h.startSchedule(ctx, s, lqs)
+		} else if s.Status == util.SchedulePending && !now.Before(s.OpensAt) {
+			h.openSchedule(ctx, s, lqs)
+		}
+	}
+}
+
+// reserveSchedule makes sure the session of a schedule holds on to its code
+// and has a cache, either of which may have been lost while it was waiting.
+func (h *Handler) reserveSchedule(ctx context.Context, s *Schedule) (*LiveQuizSession, error) {
+	lqs, ok := h.hub.GetLiveQuizSessionByCode(s.Code)
+	if !ok {
+		sess, err := h.Service.GetLiveQuizSessionBySessionID(ctx, s.ID)
+		if err != nil {
+			return nil, err
+		}
+		lqs = &LiveQuizSession{
+			Session: Session{
+				ID:                  sess.ID,
+				HostID:              sess.HostID,
+				QuizID:              sess.QuizID,
+				Status:              util.Ongoing,
+				ExemptedQuestionIDs: nil,
+			},
+			Code:    s.Code,
+			Clients: make(map[uuid.UUID]*Client),
+		}
+		if err := h.hub.AddLiveQuizSession(lqs); err != nil {
+			return nil, err
+		}
+	}
+
+	exists, err := h.Service.DoesLiveQuizSessionCacheExist(ctx, s.Code)
+	if err != nil || exists {
+		return lqs, err
+	}
+
+	var config Configurations
+	if err := json.Unmarshal([]byte(s.Config), &config); err != nil {
+		return nil, err
+	}
+	quiz, err := h.quizService.GetQuizHistoryByID(ctx, lqs.QuizID, lqs.HostID)
+	if err != nil {
+		return nil, err
+	}
+	mod, err := h.newLiveQuizSessionCache(ctx, lqs.ID, lqs.QuizID, lqs.HostID, quiz.Title, config)
+	if err != nil {
+		return nil, err
+	}
+	mod.OpensAt = &s.OpensAt
+	mod.StartsAt = &s.StartsAt
+	if s.Status == util.SchedulePending {
+		mod.Status = util.Scheduled
+	}
+
+	return lqs, h.Service.CreateLiveQuizSessionCache(ctx, s.Code, mod)
+}
+
+// openSchedule lets participants into the lobby of a scheduled session.
+func (h *Handler) openSchedule(ctx context.Context, s *Schedule, lqs *LiveQuizSession) {
+	claimed, err := h.Service.ClaimLiveQuizSchedule(ctx, s.ID, []string{util.SchedulePending}, util.ScheduleOpen)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	mod, err := h.Service.GetLiveQuizSessionCache(ctx, s.Code)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if mod.Status != util.Scheduled {
+		return
+	}
+	mod.Status = util.Idle
+	if err := h.Service.UpdateLiveQuizSessionCache(ctx, s.Code, mod); err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+
+	h.hub.Broadcast <- &Message{
+		Content: Content{
+			Type:    util.OpenLobby,
+			Payload: nil,
+		},
+		LiveQuizSessionID: lqs.ID,
+		ClientID:          uuid.Nil,
+		UserID:            &lqs.HostID,
+	}
+}
+
+// startSchedule starts a scheduled session on behalf of the host, as if they
+// had sent START_LQS. The host may have started it early already. The claim is
+// given back when the session is busy so that the next pass tries again.
+func (h *Handler) startSchedule(ctx context.Context, s *Schedule, lqs *LiveQuizSession) {
+	claimed, err := h.Service.ClaimLiveQuizSchedule(ctx, s.ID, []string{util.SchedulePending, util.ScheduleOpen}, util.ScheduleStarted)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	mod, err := h.Service.GetLiveQuizSessionCache(ctx, s.Code)
+	if err != nil {
+		log.Printf("Error occured: %v", err)
+		return
+	}
+	if mod.Status != util.Scheduled && mod.Status != util.Idle {
+		return
+	}
+
+	hostID, _ := h.hub.GetHostID(lqs.ID)
+	cl := &Client{
+		ID:                hostID,
+		UserID:            &lqs.HostID,
+		IsHost:            true,
+		LiveQuizSessionID: lqs.ID,
+		Code:              s.Code,
+		Status:            util.Joined,
+	}
+	if cl.drive(h, cl.StartLiveQuizSession) {
+		return
+	}
+	if _, err := h.Service.ClaimLiveQuizSchedule(ctx, s.ID, []string{util.ScheduleStarted}, s.Status); err != nil {
+		log.Printf("Error occured: %v", err)
+	}
+}
@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"errors"
+	"math"
+
+	"github.com/Live-Quiz-Project/Backend/internal/util"
+)
+
+// ScoringPolicy decides how many marks an answer is worth. Time is always in
+// tenths of a second, as it is stored in the responses.
+type ScoringPolicy interface {
+	// Award returns the marks for a correct answer worth mark.
+	Award(mark float64, time float64, s Scoring) int
+	// Penalty returns the marks, zero or less, for a wrong answer.
+	Penalty(s Scoring) int
+}
+
+// Scoring is what a policy needs to know about the question being scored and
+// the participant who answered it.
+type Scoring struct {
+	Policy        ScoringPolicy
+	TimeLimit     float64
+	TimeFactor    float64
+	Streak        int
+	SelectGrading string
+	// Voided questions are worth nothing either way.
+	Voided bool
+}
+
+func (s Scoring) award(mark float64, time float64) int {
+	if s.Voided {
+		return 0
+	}
+	if mark <= 0 {
+		return int(math.Round(mark))
+	}
+	return s.Policy.Award(mark, time, s)
+}
+
+func (s Scoring) penalty() int {
+	if s.Voided {
+		return 0
+	}
+	return s.Policy.Penalty(s)
+}
+
+// gradeSelection grades the options picked in a multi-select question as a
+// whole, if the question asks for it. answers are all the options of the
+// question.
+func (s Scoring) gradeSelection(picks []any, answers []any, time float64) (int, bool) {
+	if !util.IsSelectGraded(s.SelectGrading) {
+		return 0, false
+	}
+
+	picked := make(map[string]bool)
+	for _, p := range picks {
+		if p, ok := p.(map[string]any); ok {
+			if id, ok := p["id"].(string); ok {
+				picked[id] = true
+			}
+		}
+	}
+
+	options := make([]util.SelectOption, 0, len(answers))
+	for _, a := range answers {
+		a, ok := a.(map[string]any)
+		if !ok {
+			continue
+		}
+		id, _ := a["id"].(string)
+		mark, _ := a["mark"].(float64)
+		correct, _ := a["is_correct"].(bool)
+		options = append(options, util.SelectOption{
+			Mark:    int(mark),
+			Correct: correct,
+			Picked:  picked[id],
+		})
+	}
+
+	return s.award(util.GradeSelection(s.SelectGrading, options), time), true
+}
+
+// timeLeft is the time left in seconds when an answer was given. Answers given
+// in time the host added past the limit have none left.
+func (s Scoring) timeLeft(time float64) float64 {
+	return math.Max(((s.TimeLimit*10)-time)/10, 0)
+}
+
+// policy returns the scoring policy selected for a session. Sessions that do
+// not select one keep the linear time bonus.
+func (c ScoringConfigurations) policy() ScoringPolicy {
+	switch c.Policy {
+	case util.ExponentialDecay:
+		rate := c.Decay
+		if rate <= 0 {
+			rate = 3
+		}
+		return ExponentialDecayPolicy{Rate: rate}
+	case util.FixedPoints:
+		return FixedPointsPolicy{}
+	case util.StreakMultiplier:
+		step := c.Streak
+		if step <= 0 {
+			step = 0.1
+		}
+		return StreakPolicy{Step: step, Max: 2}
+	case util.NegativeMarking:
+		return NegativeMarkingPolicy{Marks: c.Penalty}
+	default:
+		return LinearPolicy{}
+	}
+}
+
+// validate rejects a policy that does not exist and a penalty that would add
+// marks for a wrong answer.
+func (c ScoringConfigurations) validate() error {
+	switch c.Policy {
+	case "", util.LinearTimeBonus, util.ExponentialDecay, util.FixedPoints, util.StreakMultiplier, util.NegativeMarking:
+	default:
+		return errors.New("unknown scoring policy " + c.Policy)
+	}
+	if c.Penalty < 0 {
+		return errors.New("the penalty cannot be negative")
+	}
+	return nil
+}
+
+// LinearPolicy adds the time factor for every second left.
+type LinearPolicy struct{}
+
+func (LinearPolicy) Award(mark float64, time float64, s Scoring) int {
+	return int(math.Round(mark + s.timeLeft(time)*s.TimeFactor))
+}
+
+func (LinearPolicy) Penalty(s Scoring) int {
+	return 0
+}
+
+// ExponentialDecayPolicy rewards fast answers the most. The bonus starts at
+// what the linear policy would give for an instant answer and decays by e
+// every 1/Rate of the time limit.
+type ExponentialDecayPolicy struct {
+	Rate float64
+}
+
+func (p ExponentialDecayPolicy) Award(mark float64, time float64, s Scoring) int {
+	if s.TimeLimit <= 0 {
+		return int(math.Round(mark))
+	}
+	elapsed := s.TimeLimit - s.timeLeft(time)
+	return int(math.Round(mark + s.TimeLimit*s.TimeFactor*math.Exp(-p.Rate*elapsed/s.TimeLimit)))
+}
+
+func (ExponentialDecayPolicy) Penalty(s Scoring) int {
+	return 0
+}
+
+// FixedPointsPolicy ignores time altogether.
+type FixedPointsPolicy struct{}
+
+func (FixedPointsPolicy) Award(mark float64, time float64, s Scoring) int {
+	return int(math.Round(mark))
+}
+
+func (FixedPointsPolicy) Penalty(s Scoring) int {
+	return 0
+}
+
+// StreakPolicy multiplies the linear marks by Step for every correct answer in
+// a row before this one, up to Max times.
+type StreakPolicy struct {
+	Step float64
+	Max  float64
+}
+
+func (p StreakPolicy) Award(mark float64, time float64, s Scoring) int {
+	multiplier := math.Min(1+p.Step*float64(s.Streak), p.Max)
+	return int(math.Round((mark + s.timeLeft(time)*s.TimeFactor) * multiplier))
+}
+
+func (StreakPolicy) Penalty(s Scoring) int {
+	return 0
+}
+
+// NegativeMarkingPolicy takes Marks off for every wrong answer.
+type NegativeMarkingPolicy struct {
+	Marks int
+}
+
+func (NegativeMarkingPolicy) Award(mark float64, time float64, s Scoring) int {
+	return LinearPolicy{}.Award(mark, time, s)
+}
+
+func (p NegativeMarkingPolicy) Penalty(s Scoring) int {
+	return -p.Marks
+}
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/Live-Quiz-Project/Backend/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoringPolicies(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy ScoringPolicy
+		mark   float64
+		time   float64
+		streak int
+		want   int
+	}{
+		{"linear instant answer", LinearPolicy{}, 100, 0, 0, 120},
+		{"linear with time left", LinearPolicy{}, 100, 40, 0, 112},
+		{"linear at the time limit", LinearPolicy{}, 100, 100, 0, 100},
+		{"linear in extended time", LinearPolicy{}, 100, 150, 0, 100},
+		{"exponential decay instant answer", ExponentialDecayPolicy{Rate: 3}, 100, 0, 0, 120},
+		{"exponential decay halfway", ExponentialDecayPolicy{Rate: 3}, 100, 50, 0, 104},
+		{"exponential decay at the time limit", ExponentialDecayPolicy{Rate: 3}, 100, 100, 0, 101},
+		{"exponential decay in extended time", ExponentialDecayPolicy{Rate: 3}, 100, 150, 0, 101},
+		{"fixed points ignore time", FixedPointsPolicy{}, 100, 40, 0, 100},
+		{"streak without a streak", StreakPolicy{Step: 0.5, Max: 2}, 100, 100, 0, 100},
+		{"streak of one", StreakPolicy{Step: 0.5, Max: 2}, 100, 50, 1, 165},
+		{"streak capped at max", StreakPolicy{Step: 0.5, Max: 2}, 100, 100, 4, 200},
+		{"streak in extended time", StreakPolicy{Step: 0.5, Max: 2}, 100, 300, 1, 150},
+		{"negative marking awards linearly", NegativeMarkingPolicy{Marks: 5}, 100, 40, 0, 112},
+		{"negative marking in extended time", NegativeMarkingPolicy{Marks: 5}, 100, 150, 0, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Scoring{
+				Policy:     tt.policy,
+				TimeLimit:  10,
+				TimeFactor: 2,
+				Streak:     tt.streak,
+			}
+			assert.Equal(t, tt.want, s.award(tt.mark, tt.time))
+		})
+	}
+}
+
+func TestScoringPenalties(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy ScoringPolicy
+		voided bool
+		want   int
+	}{
+		{"linear", LinearPolicy{}, false, 0},
+		{"exponential decay", ExponentialDecayPolicy{Rate: 3}, false, 0},
+		{"fixed points", FixedPointsPolicy{}, false, 0},
+		{"streak", StreakPolicy{Step: 0.1, Max: 2}, false, 0},
+		{"negative marking", NegativeMarkingPolicy{Marks: 5}, false, -5},
+		{"negative marking on a voided question", NegativeMarkingPolicy{Marks: 5}, true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Scoring{Policy: tt.policy, Voided: tt.voided}
+			assert.Equal(t, tt.want, s.penalty())
+		})
+	}
+}
+
+func TestScoringAward(t *testing.T) {
+	tests := []struct {
+		name    string
+		scoring Scoring
+		mark    float64
+		want    int
+	}{
+		{"voided question", Scoring{Policy: LinearPolicy{}, TimeLimit: 10, TimeFactor: 2, Voided: true}, 100, 0},
+		{"no mark gets no bonus", Scoring{Policy: LinearPolicy{}, TimeLimit: 10, TimeFactor: 2}, 0, 0},
+		{"negative mark is kept", Scoring{Policy: LinearPolicy{}, TimeLimit: 10, TimeFactor: 2}, -2.4, -2},
+		{"exponential decay without a time limit", Scoring{Policy: ExponentialDecayPolicy{Rate: 3}, TimeFactor: 2}, 100, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.scoring.award(tt.mark, 0))
+		})
+	}
+}
+
+func TestScoringConfigurationsPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ScoringConfigurations
+		want   ScoringPolicy
+	}{
+		{"default", ScoringConfigurations{}, LinearPolicy{}},
+		{"linear", ScoringConfigurations{Policy: util.LinearTimeBonus}, LinearPolicy{}},
+		{"exponential decay with default rate", ScoringConfigurations{Policy: util.ExponentialDecay}, ExponentialDecayPolicy{Rate: 3}},
+		{"exponential decay", ScoringConfigurations{Policy: util.ExponentialDecay, Decay: 5}, ExponentialDecayPolicy{Rate: 5}},
+		{"fixed points", ScoringConfigurations{Policy: util.FixedPoints}, FixedPointsPolicy{}},
+		{"streak with default step", ScoringConfigurations{Policy: util.StreakMultiplier}, StreakPolicy{Step: 0.1, Max: 2}},
+		{"streak", ScoringConfigurations{Policy: util.StreakMultiplier, Streak: 0.25}, StreakPolicy{Step: 0.25, Max: 2}},
+		{"negative marking", ScoringConfigurations{Policy: util.NegativeMarking, Penalty: 3}, NegativeMarkingPolicy{Marks: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.policy())
+		})
+	}
+}
+
+func TestScoringConfigurationsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ScoringConfigurations
+		wantErr bool
+	}{
+		{"default", ScoringConfigurations{}, false},
+		{"known policy", ScoringConfigurations{Policy: util.StreakMultiplier}, false},
+		{"unknown policy", ScoringConfigurations{Policy: "DOUBLE_OR_NOTHING"}, true},
+		{"policy in another case", ScoringConfigurations{Policy: "fixed_points"}, true},
+		{"penalty", ScoringConfigurations{Policy: util.NegativeMarking, Penalty: 3}, false},
+		{"negative penalty", ScoringConfigurations{Policy: util.NegativeMarking, Penalty: -3}, true},
+		{"negative penalty under another policy", ScoringConfigurations{Penalty: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
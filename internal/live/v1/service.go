@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"math"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,8 +15,18 @@ import (
 
 	"github.com/Live-Quiz-Project/Backend/internal/util"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// ErrProgressMoved is returned when the progress of an assignment participant
+// moved on since it was read, as when the same question is answered twice at
+// once.
+var ErrProgressMoved = errors.New("progress moved on")
+
+// ErrSessionRunning is returned when a session has to be over for something,
+// such as regrading, but is still being played.
+var ErrSessionRunning = errors.New("the session is still running")
+
 type service struct {
 	Repository
 	timeout  time.Duration
@@ -83,19 +95,29 @@ func (s *service) GetLiveQuizSessionsByUserID(ctx context.Context, userID uuid.U
 }
 
 // ---------- Live Quiz Session related service methods ---------- //
-func (s *service) CreateLiveQuizSession(ctx context.Context, quizID uuid.UUID, id uuid.UUID, code string, hostID uuid.UUID) (*CreateLiveQuizSessionResponse, error) {
+func (s *service) CreateLiveQuizSession(ctx context.Context, quizID uuid.UUID, id uuid.UUID, code string, hostID uuid.UUID, scoring ScoringConfigurations) (*CreateLiveQuizSessionResponse, error) {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
+	// The scoring is kept with the session so that it can be regraded the
+	// same way once the cache is gone.
+	scoringConfig, err := json.Marshal(scoring)
+	if err != nil {
+		return &CreateLiveQuizSessionResponse{}, err
+	}
+	sc := string(scoringConfig)
+
 	sess := &Session{
 		ID:                  id,
 		HostID:              hostID,
 		QuizID:              quizID,
 		Status:              util.Idle,
 		ExemptedQuestionIDs: nil,
+		ScoringConfig:       &sc,
+		Mode:                util.LiveMode,
 	}
 
-	sess, err := s.Repository.CreateLiveQuizSession(c, sess)
+	sess, err = s.Repository.CreateLiveQuizSession(c, sess)
 	if err != nil {
 		return &CreateLiveQuizSessionResponse{}, err
 	}
@@ -123,7 +145,7 @@ func (s *service) GetLiveQuizSessions(ctx context.Context, hub *Hub) ([]LiveQuiz
 
 	var lqsesRes []LiveQuizSessionResponse
 	for _, lqs := range lqses {
-		for _, lq := range hub.LiveQuizSessions {
+		for _, lq := range hub.GetLiveQuizSessions() {
 			if lqs.ID == lq.ID {
 				lqsesRes = append(lqsesRes, LiveQuizSessionResponse{
 					ID:     lq.ID,
@@ -249,6 +271,17 @@ func (s *service) GetLiveQuizSessionCache(ctx context.Context, code string) (*Ca
 		return &Cache{}, err
 	}
 
+	timer, err := s.Repository.GetCache(c, code+"timer")
+	if err != nil {
+		return &Cache{}, err
+	}
+	mod.Timer = Timer{}
+	if timer != "" {
+		if err := json.Unmarshal([]byte(timer), &mod.Timer); err != nil {
+			return &Cache{}, err
+		}
+	}
+
 	return mod, nil
 }
 
@@ -264,6 +297,13 @@ func (s *service) UpdateLiveQuizSessionCache(ctx context.Context, code string, c
 	return nil
 }
 
+func (s *service) UpdateLiveQuizSessionTimer(ctx context.Context, code string, timer *Timer) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return s.Repository.UpdateCache(c, code+"timer", timer)
+}
+
 func (s *service) FlushLiveQuizSessionCache(ctx context.Context, code string) error {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
@@ -368,6 +408,20 @@ func (s *service) UpdateParticipant(ctx context.Context, p *Participant) (*Parti
 	return p, nil
 }
 
+func (s *service) UpdateParticipantProfile(ctx context.Context, p *Participant) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return s.Repository.UpdateParticipantProfile(c, p)
+}
+
+func (s *service) UpdateParticipantStatus(ctx context.Context, id uuid.UUID, status string) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return s.Repository.UpdateParticipantStatus(c, id, status)
+}
+
 // ---------- Response related service methods ---------- //
 func (s *service) CreateResponse(ctx context.Context, code string, qid string, pid string, response any) error {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
@@ -487,161 +541,710 @@ func (s *service) SaveResponse(ctx context.Context, response *Response) (*Respon
 	return response, nil
 }
 
-func (s *service) GetAnswersResponseForHost(ctx context.Context, qid string, qType string, answers []any, answerCounts map[string]map[string]int) (any, error) {
-	_, cancel := context.WithTimeout(ctx, s.timeout)
+// saveGradedResponse saves a response with the marks it was graded with and
+// adds them to the participant who gave it.
+func (s *service) saveGradedResponse(ctx context.Context, response *Response, time float64, marks int, answer string) error {
+	if marks != 0 {
+		if err := s.Repository.AddParticipantMarks(ctx, response.ParticipantID, marks); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.SaveResponse(ctx, &Response{
+		ID:                response.ID,
+		LiveQuizSessionID: response.LiveQuizSessionID,
+		QuestionID:        response.QuestionID,
+		ParticipantID:     response.ParticipantID,
+		Type:              response.Type,
+		TimeTaken:         int(time),
+		Marks:             marks,
+		Answer:            answer,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// OverrideMarks sets the marks of a participant's saved response and moves
+// their total by the difference.
+func (s *service) OverrideMarks(ctx context.Context, lqsID uuid.UUID, req *MarksOverride) (*Participant, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	var res any
+	err := s.Repository.Transaction(c, func(repo Repository) error {
+		previous, err := repo.GetResponseMarks(c, lqsID, req.ParticipantID, req.QuestionID)
+		if err != nil {
+			return err
+		}
+		if previous == req.Marks {
+			return nil
+		}
 
-	switch qType {
-	case util.Choice, util.TrueFalse:
-		cAns := make([]ChoiceAnswer, 0)
-		for _, a := range answers {
-			v, ok := a.(map[string]any)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+		if err := repo.UpdateResponseMarks(c, lqsID, req.ParticipantID, req.QuestionID, req.Marks); err != nil {
+			return err
+		}
+		return repo.AddParticipantMarks(c, req.ParticipantID, req.Marks-previous)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Repository.GetParticipantByLiveQuizSessionIDAndParticipantID(c, lqsID, req.ParticipantID)
+}
+
+// RegradeQuestion scores the saved responses to a question of a finished
+// session again against a corrected answer key, with the scoring the session
+// was run with. Streaks are not replayed, so every response is scored as if
+// it started one. Responses and totals move together or not at all.
+func (s *service) RegradeQuestion(ctx context.Context, lqsID uuid.UUID, q *RegradeQuestion) ([]RegradeChange, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if q.Type != util.Choice && q.Type != util.TrueFalse {
+		return nil, errors.New("only choice and true/false questions can be regraded")
+	}
+
+	sess, err := s.Repository.GetLiveQuizSessionBySessionID(c, lqsID)
+	if err != nil {
+		return nil, err
+	}
+	running, err := s.Repository.IsLiveQuizSessionRunning(c, lqsID)
+	if err != nil {
+		return nil, err
+	}
+	if running || (sess.ClosesAt != nil && time.Now().Before(*sess.ClosesAt)) {
+		return nil, ErrSessionRunning
+	}
+
+	var config ScoringConfigurations
+	if sess.ScoringConfig != nil {
+		if err := json.Unmarshal([]byte(*sess.ScoringConfig), &config); err != nil {
+			return nil, err
+		}
+	}
+	scoring := Scoring{
+		Policy:        config.policy(),
+		TimeLimit:     q.TimeLimit,
+		TimeFactor:    q.TimeFactor,
+		SelectGrading: q.SelectGrading,
+	}
+	// Responses saved before marks were stored with them were given the sum
+	// of the options picked under the key the question was played with.
+	legacy := scoring
+	legacy.SelectGrading = ""
+
+	before, err := s.GetLeaderboard(c, lqsID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repository.Transaction(c, func(repo Repository) error {
+		responses, err := repo.GetResponsesByLiveQuizSessionIDAndQuestionID(c, lqsID, q.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range responses {
+			picks := make([]any, 0)
+			if r.Answer != "" {
+				for _, id := range strings.Split(r.Answer, util.AnswerSplitter) {
+					picks = append(picks, map[string]any{"id": id})
+				}
 			}
-			id, ok := v["id"].(string)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+
+			res, err := s.CalculateChoice(c, util.RevealingAnswer, picks, q.Answers, float64(r.TimeTaken), scoring)
+			if err != nil {
+				return err
 			}
-			content, ok := v["content"].(string)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+			previous := 0
+			if r.Marks != nil {
+				previous = *r.Marks
+			} else {
+				old, err := s.CalculateChoice(c, util.RevealingAnswer, picks, q.Original, float64(r.TimeTaken), legacy)
+				if err != nil {
+					return err
+				}
+				previous = *old.Marks
 			}
-			color, ok := v["color"].(string)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+			if r.Marks != nil && *res.Marks == previous {
+				continue
 			}
-			m, ok := v["mark"].(float64)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+
+			if err := repo.UpdateResponseMarks(c, lqsID, r.ParticipantID, q.ID, *res.Marks); err != nil {
+				return err
 			}
-			mark := int(m)
-			isCorrect, ok := v["is_correct"].(bool)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+			if *res.Marks != previous {
+				if err := repo.AddParticipantMarks(c, r.ParticipantID, *res.Marks-previous); err != nil {
+					return err
+				}
 			}
-			count := answerCounts[qid][id]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			cAns = append(cAns, ChoiceAnswer{
-				ID:      id,
-				Content: content,
-				Color:   color,
-				Mark:    mark,
-				Correct: isCorrect,
-				Count:   count,
-			})
-			res = cAns
+	after, err := s.GetLeaderboard(c, lqsID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousMarks := make(map[uuid.UUID]int)
+	previousRanks := make(map[uuid.UUID]int)
+	for i, p := range before {
+		previousMarks[p.ID] = p.Marks
+		previousRanks[p.ID] = i + 1
+	}
+	changes := make([]RegradeChange, 0)
+	for i, p := range after {
+		if previousMarks[p.ID] == p.Marks && previousRanks[p.ID] == i+1 {
+			continue
 		}
-	case util.FillBlank, util.Paragraph:
-		tAns := make([]TextAnswer, 0)
-		for _, a := range answers {
-			v, ok := a.(map[string]any)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
-			}
-			id, ok := v["id"].(string)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+		changes = append(changes, RegradeChange{
+			ParticipantID: p.ID,
+			Name:          p.Name,
+			PreviousMarks: previousMarks[p.ID],
+			Marks:         p.Marks,
+			PreviousRank:  previousRanks[p.ID],
+			Rank:          i + 1,
+		})
+	}
+
+	return changes, nil
+}
+
+// VoidQuestions exempts questions from a session: their IDs are kept on the
+// session and the marks saved for them are taken off the participants, who
+// are returned with their new marks. Questions already voided are left alone.
+func (s *service) VoidQuestions(ctx context.Context, lqsID uuid.UUID, ids []string) ([]Participant, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	deducted := make(map[uuid.UUID]int)
+	err := s.Repository.Transaction(c, func(repo Repository) error {
+		sess, err := repo.GetLiveQuizSessionBySessionID(c, lqsID)
+		if err != nil {
+			return err
+		}
+		exempted := util.ExemptedIDs(sess.ExemptedQuestionIDs)
+
+		for _, id := range ids {
+			if slices.Contains(exempted, id) {
+				continue
 			}
-			content, ok := v["content"].(string)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+			qid, err := uuid.Parse(id)
+			if err != nil {
+				return err
 			}
-			caseSensitive, ok := v["case_sensitive"].(bool)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+			exempted = append(exempted, id)
+
+			responses, err := repo.GetResponsesByLiveQuizSessionIDAndQuestionID(c, lqsID, qid)
+			if err != nil {
+				return err
 			}
-			m, ok := v["mark"].(float64)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+			for _, r := range responses {
+				if r.Marks != nil {
+					deducted[r.ParticipantID] += *r.Marks
+				}
 			}
-			mark := int(m)
+		}
 
-			tAns = append(tAns, TextAnswer{
-				ID:            id,
-				Content:       content,
-				CaseSensitive: caseSensitive,
-				Mark:          mark,
-			})
-			res = tAns
+		sess.ExemptedQuestionIDs = util.JoinExemptedIDs(exempted)
+		if _, err := repo.UpdateLiveQuizSession(c, sess, lqsID); err != nil {
+			return err
 		}
-	case util.Matching:
-		mAns := make([]MatchingAnswer, 0)
-		for _, a := range answers {
-			v, ok := a.(map[string]any)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
-			}
-			prompt, ok := v["prompt_id"].(string)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
-			}
-			option, ok := v["option_id"].(string)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+
+		for pid, marks := range deducted {
+			if marks == 0 {
+				continue
 			}
-			m, ok := v["mark"].(float64)
-			if !ok {
-				return nil, errors.New("invalid type assertion")
+			if err := repo.AddParticipantMarks(c, pid, -marks); err != nil {
+				return err
 			}
-			mark := int(m)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			mAns = append(mAns, MatchingAnswer{
-				PromptID: prompt,
-				OptionID: option,
-				Mark:     mark,
-			})
-			res = mAns
+	res := make([]Participant, 0)
+	for pid, marks := range deducted {
+		if marks == 0 {
+			continue
 		}
-	case util.Pool:
-		var pAns []any
-		for _, an := range answers {
-			ans, ok := an.([]any)
-			if !ok {
-				return nil, errors.New("invalid type assertion1")
-			}
+		p, err := s.Repository.GetParticipantByLiveQuizSessionIDAndParticipantID(c, lqsID, pid)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *p)
+	}
 
-			sqType := util.Paragraph
-			var sqID string
-			for _, answ := range ans {
-				sqID, ok = answ.(map[string]any)["qid"].(string)
-				if !ok {
-					return nil, errors.New("invalid type assertion2")
-				}
-				sqType, ok = answ.(map[string]any)["type"].(string)
-				if !ok {
-					return nil, errors.New("invalid type assertion2")
-				}
-			}
+	return res, nil
+}
 
-			switch sqType {
-			case util.Choice, util.TrueFalse:
-				cAns := make([]ChoiceAnswer, len(ans))
-				for i, a := range ans {
-					v, ok := a.(map[string]any)
-					if !ok {
-						return nil, errors.New("invalid type assertion3")
-					}
-					id, ok := v["id"].(string)
-					if !ok {
-						return nil, errors.New("invalid type assertion4")
-					}
-					content, ok := v["content"].(string)
-					if !ok {
-						return nil, errors.New("invalid type assertion5")
-					}
-					color, ok := v["color"].(string)
-					if !ok {
-						return nil, errors.New("invalid type assertion6")
-					}
-					m, ok := v["mark"].(float64)
-					if !ok {
-						return nil, errors.New("invalid type assertion7")
-					}
-					mark := int(m)
-					isCorrect, ok := v["is_correct"].(bool)
+// ---------- Progress related service methods ---------- //
+func (s *service) GetProgress(ctx context.Context, code string, pid string) (*Progress, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	progress, err := s.Repository.GetCache(c, code+"progress"+pid)
+	if err != nil {
+		return nil, err
+	}
+	if progress == "" {
+		return nil, nil
+	}
+
+	var res *Progress
+	if err := json.Unmarshal([]byte(progress), &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *service) UpdateProgress(ctx context.Context, code string, pid string, progress *Progress) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if err := s.Repository.UpdateCache(c, code+"progress"+pid, progress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ---------- Schedule related service methods ---------- //
+func (s *service) CreateLiveQuizSchedule(ctx context.Context, id uuid.UUID, code string, opensAt time.Time, startsAt time.Time, config Configurations) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	// The configuration is kept with the schedule as the session cache may
+	// be gone by the time the session starts.
+	cfg, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	status := util.SchedulePending
+	if !opensAt.After(time.Now()) {
+		status = util.ScheduleOpen
+	}
+
+	if _, err := s.Repository.CreateLiveQuizSchedule(c, &Schedule{
+		ID:       id,
+		Code:     code,
+		OpensAt:  opensAt,
+		StartsAt: startsAt,
+		Config:   string(cfg),
+		Status:   status,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetPendingLiveQuizSchedules(ctx context.Context) ([]Schedule, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return s.Repository.GetLiveQuizSchedulesByStatus(c, []string{util.SchedulePending, util.ScheduleOpen})
+}
+
+func (s *service) ClaimLiveQuizSchedule(ctx context.Context, id uuid.UUID, from []string, to string) (bool, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return s.Repository.UpdateLiveQuizScheduleStatus(c, id, from, to)
+}
+
+// ---------- Join request related service methods ---------- //
+func (s *service) CreateJoinRequest(ctx context.Context, code string, req *JoinRequest) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if err := s.Repository.CreateCache(c, code+"request"+req.ID.String(), req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetJoinRequest(ctx context.Context, code string, id string) (*JoinRequest, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	req, err := s.Repository.GetCache(c, code+"request"+id)
+	if err != nil {
+		return nil, err
+	}
+	if req == "" {
+		return nil, nil
+	}
+
+	var res *JoinRequest
+	if err := json.Unmarshal([]byte(req), &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *service) GetJoinRequests(ctx context.Context, code string) ([]JoinRequest, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	keys, err := s.Repository.ScanCache(c, code+"request*")
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]JoinRequest, 0)
+	for _, k := range keys {
+		req, err := s.Repository.GetCache(c, k)
+		if err != nil {
+			return nil, err
+		}
+		if req == "" {
+			continue
+		}
+		var r JoinRequest
+		if err := json.Unmarshal([]byte(req), &r); err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].CreatedAt.Before(res[j].CreatedAt)
+	})
+
+	return res, nil
+}
+
+func (s *service) UpdateJoinRequest(ctx context.Context, code string, req *JoinRequest) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if err := s.Repository.UpdateCache(c, code+"request"+req.ID.String(), req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *service) GetUserEmail(ctx context.Context, id uuid.UUID) (string, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	user, err := s.userRepo.GetUserByID(c, id)
+	if err != nil {
+		return "", err
+	}
+	if user == nil {
+		return "", gorm.ErrRecordNotFound
+	}
+
+	return user.Email, nil
+}
+
+// ---------- Assignment related service methods ---------- //
+func (s *service) CreateAssignment(ctx context.Context, quizID uuid.UUID, hostID uuid.UUID, opensAt time.Time, closesAt time.Time, config Configurations) (*Session, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	// The whole configuration is kept with the session, as there is no
+	// session cache to hold it for an assignment.
+	scoringConfig, err := json.Marshal(config.ScoringConfig)
+	if err != nil {
+		return nil, err
+	}
+	sc := string(scoringConfig)
+	cfg, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	cf := string(cfg)
+
+	return s.Repository.CreateLiveQuizSession(c, &Session{
+		ID:                  uuid.New(),
+		HostID:              hostID,
+		QuizID:              quizID,
+		Status:              util.Ongoing,
+		ExemptedQuestionIDs: nil,
+		ScoringConfig:       &sc,
+		Mode:                util.AssignmentMode,
+		OpensAt:             &opensAt,
+		ClosesAt:            &closesAt,
+		Config:              &cf,
+	})
+}
+
+func (s *service) GetAssignmentProgress(ctx context.Context, pid uuid.UUID) (*Progress, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	ap, err := s.Repository.GetAssignmentProgress(c, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *Progress
+	if err := json.Unmarshal([]byte(ap.Progress), &res); err != nil {
+		return nil, err
+	}
+	res.saved = ap.CurrentQuestion
+
+	return res, nil
+}
+
+// SaveAssignmentProgress saves the progress of an assignment participant. Once
+// saved, it is only saved over if it has not moved on since it was read.
+func (s *service) SaveAssignmentProgress(ctx context.Context, lqsID uuid.UUID, pid uuid.UUID, progress *Progress) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	p, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+
+	ap := &AssignmentProgress{
+		ParticipantID:     pid,
+		LiveQuizSessionID: lqsID,
+		Progress:          string(p),
+		CurrentQuestion:   progress.CurrentQuestion,
+	}
+	if progress.saved == 0 {
+		if _, err := s.Repository.SaveAssignmentProgress(c, ap); err != nil {
+			return err
+		}
+	} else {
+		ok, err := s.Repository.UpdateAssignmentProgress(c, ap, progress.saved)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrProgressMoved
+		}
+	}
+	progress.saved = progress.CurrentQuestion
+
+	return nil
+}
+
+// AnswerAssignment saves the responses grade saves with the service it is
+// given and the progress of the participant in one transaction. Nothing is
+// saved if the progress moved on in the meantime.
+func (s *service) AnswerAssignment(ctx context.Context, lqsID uuid.UUID, pid uuid.UUID, progress *Progress, grade func(Service) error) error {
+	return s.Repository.Transaction(ctx, func(repo Repository) error {
+		tx := &service{
+			Repository: repo,
+			timeout:    s.timeout,
+			userRepo:   s.userRepo,
+		}
+		if err := grade(tx); err != nil {
+			return err
+		}
+		return tx.SaveAssignmentProgress(ctx, lqsID, pid, progress)
+	})
+}
+
+func (s *service) GetAnswersResponseForHost(ctx context.Context, qid string, qType string, answers []any, answerCounts map[string]map[string]int) (any, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var res any
+
+	switch qType {
+	case util.Choice, util.TrueFalse:
+		cAns := make([]ChoiceAnswer, 0)
+		for _, a := range answers {
+			v, ok := a.(map[string]any)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			id, ok := v["id"].(string)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			content, ok := v["content"].(string)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			color, ok := v["color"].(string)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			m, ok := v["mark"].(float64)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			mark := int(m)
+			isCorrect, ok := v["is_correct"].(bool)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			count := answerCounts[qid][id]
+
+			cAns = append(cAns, ChoiceAnswer{
+				ID:      id,
+				Content: content,
+				Color:   color,
+				Mark:    mark,
+				Correct: isCorrect,
+				Count:   count,
+			})
+			res = cAns
+		}
+	case util.Poll:
+		res = pollResults(answers, answerCounts[qid])
+	case util.WordCloud:
+		res = wordFrequencies(answerCounts[qid])
+	case util.FillBlank, util.Paragraph:
+		tAns := make([]TextAnswer, 0)
+		for _, a := range answers {
+			v, ok := a.(map[string]any)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			id, ok := v["id"].(string)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			content, ok := v["content"].(string)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			caseSensitive, ok := v["case_sensitive"].(bool)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			m, ok := v["mark"].(float64)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			mark := int(m)
+
+			tAns = append(tAns, TextAnswer{
+				ID:            id,
+				Content:       content,
+				CaseSensitive: caseSensitive,
+				Mark:          mark,
+			})
+			res = tAns
+		}
+	case util.Numeric:
+		nAns, err := numericAnswers(answers)
+		if err != nil {
+			return nil, err
+		}
+		res = NumericHostResponse{
+			Answers:   nAns,
+			Histogram: numericHistogram(answerCounts[qid]),
+		}
+	case util.Slider:
+		sAns, err := sliderAnswers(answers)
+		if err != nil {
+			return nil, err
+		}
+		res = SliderHostResponse{
+			Answers:   sAns,
+			Histogram: numericHistogram(answerCounts[qid]),
+		}
+	case util.Hotspot:
+		hAns, err := hotspotAnswers(answers)
+		if err != nil {
+			return nil, err
+		}
+		res = HotspotHostResponse{
+			Answers: hAns,
+			Clicks:  hotspotClicks(answerCounts[qid]),
+		}
+	case util.Ordering:
+		oAns, err := orderingAnswers(answers)
+		if err != nil {
+			return nil, err
+		}
+		res = oAns
+	case util.Matching:
+		mAns := make([]MatchingAnswer, 0)
+		for _, a := range answers {
+			v, ok := a.(map[string]any)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			prompt, ok := v["prompt_id"].(string)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			option, ok := v["option_id"].(string)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			m, ok := v["mark"].(float64)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			mark := int(m)
+
+			mAns = append(mAns, MatchingAnswer{
+				PromptID: prompt,
+				OptionID: option,
+				Mark:     mark,
+			})
+			res = mAns
+		}
+	case util.Pool:
+		var pAns []any
+		for _, an := range answers {
+			ans, ok := an.([]any)
+			if !ok {
+				return nil, errors.New("invalid type assertion1")
+			}
+
+			sqType := util.Paragraph
+			var sqID string
+			for _, answ := range ans {
+				sqID, ok = answ.(map[string]any)["qid"].(string)
+				if !ok {
+					return nil, errors.New("invalid type assertion2")
+				}
+				sqType, ok = answ.(map[string]any)["type"].(string)
+				if !ok {
+					return nil, errors.New("invalid type assertion2")
+				}
+			}
+
+			switch sqType {
+			case util.Choice, util.TrueFalse:
+				cAns := make([]ChoiceAnswer, len(ans))
+				for i, a := range ans {
+					v, ok := a.(map[string]any)
+					if !ok {
+						return nil, errors.New("invalid type assertion3")
+					}
+					id, ok := v["id"].(string)
+					if !ok {
+						return nil, errors.New("invalid type assertion4")
+					}
+					content, ok := v["content"].(string)
+					if !ok {
+						return nil, errors.New("invalid type assertion5")
+					}
+					color, ok := v["color"].(string)
+					if !ok {
+						return nil, errors.New("invalid type assertion6")
+					}
+					m, ok := v["mark"].(float64)
+					if !ok {
+						return nil, errors.New("invalid type assertion7")
+					}
+					mark := int(m)
+					isCorrect, ok := v["is_correct"].(bool)
 					if !ok {
 						return nil, errors.New("invalid type assertion8")
 					}
@@ -660,6 +1263,16 @@ func (s *service) GetAnswersResponseForHost(ctx context.Context, qid string, qTy
 					Type:    sqType,
 					Content: cAns,
 				})
+			case util.Poll:
+				pAns = append(pAns, PoolAnswer{
+					Type:    sqType,
+					Content: pollResults(ans, answerCounts[sqID]),
+				})
+			case util.WordCloud:
+				pAns = append(pAns, PoolAnswer{
+					Type:    sqType,
+					Content: wordFrequencies(answerCounts[sqID]),
+				})
 			case util.FillBlank, util.Paragraph:
 				tAns := make([]TextAnswer, len(ans))
 				for i, a := range ans {
@@ -696,6 +1309,51 @@ func (s *service) GetAnswersResponseForHost(ctx context.Context, qid string, qTy
 					Type:    sqType,
 					Content: tAns,
 				})
+			case util.Numeric:
+				nAns, err := numericAnswers(ans)
+				if err != nil {
+					return nil, err
+				}
+				pAns = append(pAns, PoolAnswer{
+					Type: sqType,
+					Content: NumericHostResponse{
+						Answers:   nAns,
+						Histogram: numericHistogram(answerCounts[sqID]),
+					},
+				})
+			case util.Slider:
+				sAns, err := sliderAnswers(ans)
+				if err != nil {
+					return nil, err
+				}
+				pAns = append(pAns, PoolAnswer{
+					Type: sqType,
+					Content: SliderHostResponse{
+						Answers:   sAns,
+						Histogram: numericHistogram(answerCounts[sqID]),
+					},
+				})
+			case util.Hotspot:
+				hAns, err := hotspotAnswers(ans)
+				if err != nil {
+					return nil, err
+				}
+				pAns = append(pAns, PoolAnswer{
+					Type: sqType,
+					Content: HotspotHostResponse{
+						Answers: hAns,
+						Clicks:  hotspotClicks(answerCounts[sqID]),
+					},
+				})
+			case util.Ordering:
+				oAns, err := orderingAnswers(ans)
+				if err != nil {
+					return nil, err
+				}
+				pAns = append(pAns, PoolAnswer{
+					Type:    sqType,
+					Content: oAns,
+				})
 			case util.Matching:
 				mAns := make([]MatchingAnswer, len(ans))
 				for i, a := range ans {
@@ -733,657 +1391,1264 @@ func (s *service) GetAnswersResponseForHost(ctx context.Context, qid string, qTy
 		}
 	}
 
-	return res, nil
+	return res, nil
+}
+
+func (s *service) CalculateChoice(ctx context.Context, status string, options []any, answers []any, time float64, scoring Scoring) (ChoiceAnswerResponse, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, marks, err := gradeChoice(options, answers, time, scoring)
+	if err != nil {
+		return ChoiceAnswerResponse{}, err
+	}
+
+	switch status {
+	case util.Answering:
+		picked := make([]ChoiceAnswer, len(res))
+		for i, r := range res {
+			picked[i] = ChoiceAnswer{
+				ID:      r.ID,
+				Content: r.Content,
+				Color:   r.Color,
+			}
+		}
+		return ChoiceAnswerResponse{
+			Answers: picked,
+			Marks:   nil,
+			Time:    int(time),
+		}, nil
+	case util.RevealingAnswer:
+		return ChoiceAnswerResponse{
+			Answers: res,
+			Marks:   &marks,
+			Time:    int(time),
+		}, nil
+	}
+
+	return ChoiceAnswerResponse{
+		Answers: make([]ChoiceAnswer, 0),
+		Marks:   nil,
+		Time:    int(time),
+	}, nil
+}
+
+func (s *service) CalculateAndSaveChoiceResponse(ctx context.Context, options []any, answers []any, answerCounts map[string]int, time float64, scoring Scoring, response *Response) (ChoiceAnswerResponse, map[string]int, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, marks, err := gradeChoice(options, answers, time, scoring)
+	if err != nil {
+		return ChoiceAnswerResponse{}, nil, err
+	}
+
+	ids := make([]string, len(options))
+	for i, o := range options {
+		ids[i], _ = o.(map[string]any)["id"].(string)
+		answerCounts[ids[i]] += 1
+	}
+
+	if err := s.saveGradedResponse(ctx, response, time, marks, strings.Join(ids, util.AnswerSplitter)); err != nil {
+		return ChoiceAnswerResponse{}, nil, err
+	}
+
+	return ChoiceAnswerResponse{
+		Answers: res,
+		Marks:   &marks,
+		Time:    int(time),
+	}, answerCounts, nil
+}
+
+// gradeChoice scores the options picked in a choice question, one by one or
+// as a whole if the question is graded that way.
+func gradeChoice(options []any, answers []any, time float64, scoring Scoring) ([]ChoiceAnswer, int, error) {
+	marks := 0
+	res := make([]ChoiceAnswer, 0)
+
+	for _, o := range options {
+		oID, ok := o.(map[string]any)["id"].(string)
+		if !ok {
+			return nil, 0, errors.New("invalid type assertion")
+		}
+		for _, a := range answers {
+			aID, ok := a.(map[string]any)["id"].(string)
+			if !ok {
+				return nil, 0, errors.New("invalid type assertion")
+			}
+			aContent, ok := a.(map[string]any)["content"].(string)
+			if !ok {
+				return nil, 0, errors.New("invalid type assertion")
+			}
+			aColor, ok := a.(map[string]any)["color"].(string)
+			if !ok {
+				return nil, 0, errors.New("invalid type assertion")
+			}
+			aIsCorrect, ok := a.(map[string]any)["is_correct"].(bool)
+			if !ok {
+				return nil, 0, errors.New("invalid type assertion")
+			}
+			aM, ok := a.(map[string]any)["mark"].(float64)
+			if !ok {
+				return nil, 0, errors.New("invalid type assertion")
+			}
+
+			mark := scoring.award(aM, time)
+
+			if !aIsCorrect {
+				mark += scoring.penalty()
+			}
+
+			if oID == aID {
+				marks += mark
+				res = append(res, ChoiceAnswer{
+					ID:      aID,
+					Content: aContent,
+					Color:   aColor,
+					Mark:    mark,
+					Correct: aIsCorrect,
+				})
+			}
+		}
+	}
+
+	if graded, ok := scoring.gradeSelection(options, answers, time); ok {
+		marks = graded
+	}
+
+	return res, marks, nil
+}
+
+func (s *service) CalculatePoll(ctx context.Context, options []any, answers []any, time float64) (PollAnswerResponse, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, err := pollPicks(options, answers)
+	if err != nil {
+		return PollAnswerResponse{}, err
+	}
+
+	return PollAnswerResponse{
+		Answers: res,
+		Time:    int(time),
+	}, nil
+}
+
+func (s *service) SavePollResponse(ctx context.Context, options []any, answers []any, answerCounts map[string]int, time float64, response *Response) (PollAnswerResponse, map[string]int, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, err := pollPicks(options, answers)
+	if err != nil {
+		return PollAnswerResponse{}, answerCounts, err
+	}
+
+	ids := make([]string, len(res))
+	for i, r := range res {
+		answerCounts[r.ID] += 1
+		ids[i] = r.ID
+	}
+
+	if err := s.saveGradedResponse(ctx, response, time, 0, strings.Join(ids, util.AnswerSplitter)); err != nil {
+		return PollAnswerResponse{}, answerCounts, err
+	}
+
+	return PollAnswerResponse{
+		Answers: res,
+		Time:    int(time),
+	}, answerCounts, nil
+}
+
+func (s *service) CalculateWordCloud(ctx context.Context, words []string, time float64) (WordCloudAnswerResponse, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return WordCloudAnswerResponse{
+		Answers: words,
+		Time:    int(time),
+	}, nil
+}
+
+func (s *service) SaveWordCloudResponse(ctx context.Context, words []string, answerCounts map[string]int, time float64, response *Response) (WordCloudAnswerResponse, map[string]int, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	for _, w := range words {
+		answerCounts[w] += 1
+	}
+
+	if err := s.saveGradedResponse(ctx, response, time, 0, strings.Join(words, util.AnswerSplitter)); err != nil {
+		return WordCloudAnswerResponse{}, answerCounts, err
+	}
+
+	return WordCloudAnswerResponse{
+		Answers: words,
+		Time:    int(time),
+	}, answerCounts, nil
+}
+
+// pollPicks returns the options of a poll a participant voted for.
+func pollPicks(options []any, answers []any) ([]ChoiceAnswer, error) {
+	res := make([]ChoiceAnswer, 0)
+	for _, o := range options {
+		oID, ok := o.(map[string]any)["id"].(string)
+		if !ok {
+			return nil, errors.New("invalid type assertion")
+		}
+		for _, a := range answers {
+			aID, ok := a.(map[string]any)["id"].(string)
+			if !ok {
+				return nil, errors.New("invalid type assertion")
+			}
+			if oID == aID {
+				aContent, _ := a.(map[string]any)["content"].(string)
+				aColor, _ := a.(map[string]any)["color"].(string)
+				res = append(res, ChoiceAnswer{
+					ID:      aID,
+					Content: aContent,
+					Color:   aColor,
+				})
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// pollResults works out the share of votes every option of a poll got.
+func pollResults(answers []any, counts map[string]int) PollHostResponse {
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+
+	res := make([]PollResult, 0, len(answers))
+	for _, a := range answers {
+		v, ok := a.(map[string]any)
+		if !ok {
+			continue
+		}
+		id, _ := v["id"].(string)
+		content, _ := v["content"].(string)
+		color, _ := v["color"].(string)
+
+		r := PollResult{
+			ID:      id,
+			Content: content,
+			Color:   color,
+			Count:   counts[id],
+		}
+		if total > 0 {
+			r.Percentage = float64(r.Count) / float64(total) * 100
+		}
+		res = append(res, r)
+	}
+
+	return PollHostResponse{
+		Results: res,
+		Total:   total,
+	}
+}
+
+// wordFrequencies turns the counts of word cloud entries into frequencies,
+// most entered first.
+func wordFrequencies(counts map[string]int) WordCloudHostResponse {
+	total, max := 0, 0
+	for _, c := range counts {
+		total += c
+		if c > max {
+			max = c
+		}
+	}
+
+	res := make([]WordFrequency, 0, len(counts))
+	for w, c := range counts {
+		if c <= 0 {
+			continue
+		}
+		res = append(res, WordFrequency{
+			Word:      w,
+			Count:     c,
+			Frequency: float64(c) / float64(max),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Word < res[j].Word
+	})
+
+	return WordCloudHostResponse{
+		Words: res,
+		Total: total,
+	}
+}
+
+// wordCloudContent reads the entries a participant sent for a word cloud,
+// either as one text or as a list of them, normalized and without blanks.
+func wordCloudContent(options any) []string {
+	var entries []any
+	switch v := options.(type) {
+	case string:
+		entries = []any{v}
+	case []any:
+		entries = v
+	}
+
+	res := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e, ok := e.(string); ok {
+			if w := util.NormalizeWord(e); w != "" {
+				res = append(res, w)
+			}
+		}
+	}
+	return res
+}
+
+func (s *service) CalculateFillBlank(ctx context.Context, status string, options []any, answers []any, time float64, scoring Scoring) (TextAnswerResponse, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, marks, err := gradeFillBlank(options, answers, time, scoring)
+	if err != nil {
+		return TextAnswerResponse{}, err
+	}
+
+	switch status {
+	case util.Answering:
+		picked := make([]TextAnswer, len(res))
+		for i, r := range res {
+			picked[i] = TextAnswer{
+				ID:            r.ID,
+				CaseSensitive: r.CaseSensitive,
+				Content:       r.Content,
+			}
+		}
+		return TextAnswerResponse{
+			Answers: picked,
+			Marks:   nil,
+			Time:    int(time),
+		}, nil
+	case util.RevealingAnswer:
+		return TextAnswerResponse{
+			Answers: res,
+			Marks:   &marks,
+			Time:    int(time),
+		}, nil
+	}
+
+	return TextAnswerResponse{
+		Answers: make([]TextAnswer, 0),
+		Marks:   nil,
+		Time:    int(time),
+	}, nil
 }
 
-func (s *service) CalculateChoice(ctx context.Context, status string, options []any, answers []any, time float64, timeLimit float64, timeFactor float64) (ChoiceAnswerResponse, error) {
-	_, cancel := context.WithTimeout(ctx, s.timeout)
+func (s *service) CalculateAndSaveFillBlankResponse(ctx context.Context, options []any, answers []any, time float64, scoring Scoring, response *Response) (TextAnswerResponse, error) {
+	_, cancel := context.WithTimeout(context.Background(), s.timeout)
 	defer cancel()
 
-	timeLeft := (timeLimit * 10) - time
-	timeLeft /= 10
-	timeBonus := timeLeft * timeFactor
+	res, marks, err := gradeFillBlank(options, answers, time, scoring)
+	if err != nil {
+		return TextAnswerResponse{}, err
+	}
+
+	contents := make([]string, len(options))
+	for i, o := range options {
+		contents[i], _ = o.(map[string]any)["content"].(string)
+	}
+
+	if err := s.saveGradedResponse(ctx, response, time, marks, strings.Join(contents, util.AnswerSplitter)); err != nil {
+		return TextAnswerResponse{}, err
+	}
+
+	return TextAnswerResponse{
+		Answers: res,
+		Marks:   &marks,
+		Time:    int(time),
+	}, nil
+}
 
+// gradeFillBlank matches every blank filled in against its answer.
+func gradeFillBlank(options []any, answers []any, time float64, scoring Scoring) ([]TextAnswer, int, error) {
 	marks := 0
-	res := make([]ChoiceAnswer, 0)
+	res := make([]TextAnswer, 0)
 
 	for _, o := range options {
 		oID, ok := o.(map[string]any)["id"].(string)
 		if !ok {
-			return ChoiceAnswerResponse{}, errors.New("invalid type assertion")
+			return nil, 0, errors.New("invalid type assertion")
+		}
+		oContent, ok := o.(map[string]any)["content"].(string)
+		if !ok {
+			return nil, 0, errors.New("invalid type assertion")
 		}
 		for _, a := range answers {
 			aID, ok := a.(map[string]any)["id"].(string)
 			if !ok {
-				return ChoiceAnswerResponse{}, errors.New("invalid type assertion")
+				return nil, 0, errors.New("invalid type assertion")
 			}
 			aContent, ok := a.(map[string]any)["content"].(string)
 			if !ok {
-				return ChoiceAnswerResponse{}, errors.New("invalid type assertion")
-			}
-			aColor, ok := a.(map[string]any)["color"].(string)
-			if !ok {
-				return ChoiceAnswerResponse{}, errors.New("invalid type assertion")
+				return nil, 0, errors.New("invalid type assertion")
 			}
-			aIsCorrect, ok := a.(map[string]any)["is_correct"].(bool)
+			aCaseSensitive, ok := a.(map[string]any)["case_sensitive"].(bool)
 			if !ok {
-				return ChoiceAnswerResponse{}, errors.New("invalid type assertion")
+				return nil, 0, errors.New("invalid type assertion")
 			}
+			aMatchMode, _ := a.(map[string]any)["match_mode"].(string)
+			aMatchThreshold, _ := a.(map[string]any)["match_threshold"].(float64)
 			aM, ok := a.(map[string]any)["mark"].(float64)
 			if !ok {
-				return ChoiceAnswerResponse{}, errors.New("invalid type assertion")
+				return nil, 0, errors.New("invalid type assertion")
 			}
 
-			tb := 0.0
-			if aM > 0 {
-				tb = timeBonus
-			}
-			mark := int(math.Round(aM + tb))
+			mark := scoring.award(aM, time)
 
+			isCorrect := util.MatchText(aMatchMode, aMatchThreshold, aCaseSensitive, aContent, oContent)
 			if oID == aID {
-				marks += mark
-				if status == util.Answering {
-					mark += 1000
-					res = append(res, ChoiceAnswer{
-						ID:      aID,
-						Content: aContent,
-						Color:   aColor,
-					})
-				}
-				if status == util.RevealingAnswer {
-					res = append(res, ChoiceAnswer{
-						ID:      aID,
-						Content: aContent,
-						Color:   aColor,
-						Mark:    mark,
-						Correct: aIsCorrect,
-					})
+				m := scoring.penalty()
+				if isCorrect {
+					m = mark
 				}
+				marks += m
+				res = append(res, TextAnswer{
+					ID:            aID,
+					CaseSensitive: aCaseSensitive,
+					Content:       oContent,
+					Answer:        aContent,
+					Correct:       isCorrect,
+					Mark:          m,
+				})
 			}
 		}
 	}
 
-	var mRes *int
+	return res, marks, nil
+}
+
+func (s *service) CalculateParagraph(ctx context.Context, status string, content string, answers []any, time float64, scoring Scoring) (any, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, _, err := gradeParagraph(content, answers, time, scoring)
+	if err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case util.Answering:
+		return ParagraphAnswerResponse{
+			Answer: content,
+			Marks:  nil,
+			Time:   int(time),
+		}, nil
+	case util.RevealingAnswer:
+		return res, nil
+	}
+
+	return nil, nil
+}
+
+func (s *service) CalculateAndSaveParagraphResponse(ctx context.Context, content string, answers []any, time float64, scoring Scoring, response *Response) (any, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, marks, err := gradeParagraph(content, answers, time, scoring)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.saveGradedResponse(ctx, response, time, marks, content); err != nil {
+		return TextAnswerResponse{}, err
+	}
+
+	return res, nil
+}
+
+// gradeParagraph matches a paragraph against its answer. A paragraph with no
+// answer to match is left for the host to grade, and only the text is
+// returned.
+func gradeParagraph(content string, answers []any, time float64, scoring Scoring) (any, int, error) {
+	if len(answers) == 0 {
+		return content, 0, nil
+	}
+
+	answer, ok := answers[0].(map[string]any)
+	if !ok {
+		return nil, 0, errors.New("invalid type assertion")
+	}
+	aID, ok := answer["id"].(string)
+	if !ok {
+		return nil, 0, errors.New("invalid type assertion")
+	}
+	aContent, ok := answer["content"].(string)
+	if !ok {
+		return nil, 0, errors.New("invalid type assertion")
+	}
+	aCaseSensitive, ok := answer["case_sensitive"].(bool)
+	if !ok {
+		return nil, 0, errors.New("invalid type assertion")
+	}
+	aMatchMode, _ := answer["match_mode"].(string)
+	aMatchThreshold, _ := answer["match_threshold"].(float64)
+	aM, ok := (answer["mark"].(float64))
+	if !ok {
+		return nil, 0, errors.New("invalid type assertion")
+	}
+
+	mark := scoring.award(aM, time)
+
+	marks := scoring.penalty()
+	isCorrect := util.MatchText(aMatchMode, aMatchThreshold, aCaseSensitive, aContent, content)
+	if isCorrect {
+		marks = mark
+	}
+
+	return TextAnswerResponse{
+		Answers: []TextAnswer{{
+			ID:            aID,
+			CaseSensitive: aCaseSensitive,
+			Content:       content,
+			Answer:        aContent,
+			Correct:       isCorrect,
+			Mark:          mark,
+		}},
+		Marks: &marks,
+		Time:  int(time),
+	}, marks, nil
+}
+
+func (s *service) CalculateNumeric(ctx context.Context, status string, content string, answers []any, time float64, scoring Scoring) (NumericAnswerResponse, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
 	if status == util.Answering {
-		mRes = nil
+		return NumericAnswerResponse{
+			Answer: content,
+			Marks:  nil,
+			Time:   int(time),
+		}, nil
 	}
-	if status == util.RevealingAnswer {
-		mRes = &marks
+
+	res, marks, isCorrect, err := gradeNumeric(content, answers, time, scoring)
+	if err != nil {
+		return NumericAnswerResponse{}, err
 	}
 
-	return ChoiceAnswerResponse{
+	return NumericAnswerResponse{
 		Answers: res,
-		Marks:   mRes,
+		Answer:  content,
+		Correct: isCorrect,
+		Marks:   &marks,
 		Time:    int(time),
 	}, nil
 }
 
-func (s *service) CalculateAndSaveChoiceResponse(ctx context.Context, options []any, answers []any, answerCounts map[string]int, time float64, timeLimit float64, timeFactor float64, response *Response) (ChoiceAnswerResponse, map[string]int, error) {
+func (s *service) CalculateAndSaveNumericResponse(ctx context.Context, content string, answers []any, answerCounts map[string]int, time float64, scoring Scoring, response *Response) (NumericAnswerResponse, map[string]int, error) {
 	_, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	timeLeft := (timeLimit * 10) - time
-	timeLeft /= 10
-	timeBonus := timeLeft * timeFactor
+	res, marks, isCorrect, err := gradeNumeric(content, answers, time, scoring)
+	if err != nil {
+		return NumericAnswerResponse{}, answerCounts, err
+	}
 
-	marks := 0
-	res := make([]ChoiceAnswer, 0)
-	stringifyOptions := make([]string, len(options))
+	unit := ""
+	if len(res) > 0 {
+		unit = res[0].Unit
+	}
+	if value, ok := util.ParseNumber(content, unit); ok {
+		answerCounts[strconv.FormatFloat(value, 'f', -1, 64)] += 1
+	}
 
-	for i, o := range options {
-		oID, ok := o.(map[string]any)["id"].(string)
+	if err := s.saveGradedResponse(ctx, response, time, marks, content); err != nil {
+		return NumericAnswerResponse{}, answerCounts, err
+	}
+
+	return NumericAnswerResponse{
+		Answers: res,
+		Answer:  content,
+		Correct: isCorrect,
+		Marks:   &marks,
+		Time:    int(time),
+	}, answerCounts, nil
+}
+
+// numericAnswers reads the accepted answers of a numeric question.
+func numericAnswers(answers []any) ([]NumericAnswer, error) {
+	res := make([]NumericAnswer, 0)
+	for _, a := range answers {
+		v, ok := a.(map[string]any)
 		if !ok {
-			return ChoiceAnswerResponse{}, nil, errors.New("invalid type assertion")
+			return nil, errors.New("invalid type assertion")
 		}
-		answerCounts[oID] += 1
-		stringifyOptions[i] = string(oID)
-		for _, a := range answers {
-			aID, ok := a.(map[string]any)["id"].(string)
-			if !ok {
-				return ChoiceAnswerResponse{}, nil, errors.New("invalid type assertion")
-			}
-			aContent, ok := a.(map[string]any)["content"].(string)
-			if !ok {
-				return ChoiceAnswerResponse{}, nil, errors.New("invalid type assertion")
-			}
-			aColor, ok := a.(map[string]any)["color"].(string)
-			if !ok {
-				return ChoiceAnswerResponse{}, nil, errors.New("invalid type assertion")
-			}
-			aIsCorrect, ok := a.(map[string]any)["is_correct"].(bool)
-			if !ok {
-				return ChoiceAnswerResponse{}, nil, errors.New("invalid type assertion")
-			}
-			aM, ok := a.(map[string]any)["mark"].(float64)
-			if !ok {
-				return ChoiceAnswerResponse{}, nil, errors.New("invalid type assertion")
-			}
+		id, ok := v["id"].(string)
+		if !ok {
+			return nil, errors.New("invalid type assertion")
+		}
+		m, ok := v["mark"].(float64)
+		if !ok {
+			return nil, errors.New("invalid type assertion")
+		}
+		value, _ := v["value"].(float64)
+		tolerance, _ := v["tolerance"].(float64)
+		minValue, _ := v["min_value"].(float64)
+		maxValue, _ := v["max_value"].(float64)
+		unit, _ := v["unit"].(string)
+
+		res = append(res, NumericAnswer{
+			ID:        id,
+			Value:     value,
+			Tolerance: tolerance,
+			MinValue:  minValue,
+			MaxValue:  maxValue,
+			Unit:      unit,
+			Mark:      int(m),
+		})
+	}
 
-			tb := 0.0
-			if aM > 0 {
-				tb = timeBonus
-			}
-			mark := int(math.Round(aM + tb))
+	return res, nil
+}
 
-			if oID == aID {
-				marks += mark
-				res = append(res, ChoiceAnswer{
-					ID:      aID,
-					Content: aContent,
-					Color:   aColor,
-					Mark:    mark,
-					Correct: aIsCorrect,
-				})
-			}
+// gradeNumeric checks a numeric answer against every accepted answer of the
+// question and awards the marks of the first one it falls into.
+func gradeNumeric(content string, answers []any, time float64, scoring Scoring) ([]NumericAnswer, int, bool, error) {
+	res, err := numericAnswers(answers)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	marks := 0
+	isCorrect := false
+	for _, a := range res {
+		value, ok := util.ParseNumber(content, a.Unit)
+		if ok && util.MatchNumber(value, a.Value, a.Tolerance, a.MinValue, a.MaxValue) {
+			isCorrect = true
+			marks = scoring.award(float64(a.Mark), time)
+			break
 		}
 	}
+	if !isCorrect && len(res) > 0 {
+		marks = scoring.penalty()
+	}
 
-	p, err := s.GetParticipantByID(context.Background(), response.ParticipantID)
-	if err != nil {
-		return ChoiceAnswerResponse{}, nil, err
+	return res, marks, isCorrect, nil
+}
+
+// numericContent reads the value a participant submitted for a numeric
+// question, which clients may send either as a number or as text.
+func numericContent(options any) string {
+	switch v := options.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
 	}
-	p.Marks += marks
-	_, err = s.UpdateParticipant(context.Background(), p)
+	return ""
+}
+
+// numericHistogram turns the counts of submitted values into buckets sorted
+// by value.
+func numericHistogram(counts map[string]int) []NumericBucket {
+	res := make([]NumericBucket, 0, len(counts))
+	for k, c := range counts {
+		v, err := strconv.ParseFloat(k, 64)
+		if err != nil {
+			continue
+		}
+		res = append(res, NumericBucket{
+			Value: v,
+			Count: c,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Value < res[j].Value })
+
+	return res
+}
+
+func (s *service) CalculateSlider(ctx context.Context, status string, value *float64, answers []any, time float64, scoring Scoring) (SliderAnswerResponse, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, value, closeness, marks, err := gradeSlider(value, answers, time, scoring)
 	if err != nil {
-		return ChoiceAnswerResponse{}, nil, err
+		return SliderAnswerResponse{}, err
 	}
 
-	stringifyAnswer := strings.Join(stringifyOptions, util.AnswerSplitter)
-	if _, err := s.SaveResponse(context.Background(), &Response{
-		ID:                response.ID,
-		LiveQuizSessionID: response.LiveQuizSessionID,
-		QuestionID:        response.QuestionID,
-		ParticipantID:     response.ParticipantID,
-		Type:              response.Type,
-		TimeTaken:         int(time),
-		Answer:            stringifyAnswer,
-	}); err != nil {
-		return ChoiceAnswerResponse{}, nil, err
+	if status == util.Answering {
+		return SliderAnswerResponse{
+			Answer: value,
+			Marks:  nil,
+			Time:   int(time),
+		}, nil
 	}
 
-	return ChoiceAnswerResponse{
-		Answers: res,
-		Marks:   &marks,
-		Time:    int(time),
-	}, answerCounts, nil
+	return SliderAnswerResponse{
+		Answers:   res,
+		Answer:    value,
+		Closeness: closeness,
+		Correct:   closeness == 1,
+		Marks:     &marks,
+		Time:      int(time),
+	}, nil
 }
 
-func (s *service) CalculateFillBlank(ctx context.Context, status string, options []any, answers []any, time float64, timeLimit float64, timeFactor float64) (TextAnswerResponse, error) {
+func (s *service) CalculateAndSaveSliderResponse(ctx context.Context, value *float64, answers []any, answerCounts map[string]int, time float64, scoring Scoring, response *Response) (SliderAnswerResponse, map[string]int, error) {
 	_, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	timeLeft := (timeLimit * 10) - time
-	timeLeft /= 10
-	timeBonus := timeLeft * timeFactor
+	res, value, closeness, marks, err := gradeSlider(value, answers, time, scoring)
+	if err != nil {
+		return SliderAnswerResponse{}, answerCounts, err
+	}
+
+	answer := ""
+	if value != nil {
+		answer = strconv.FormatFloat(*value, 'f', -1, 64)
+		answerCounts[answer] += 1
+	}
 
-	marks := 0
-	res := make([]TextAnswer, 0)
+	if err := s.saveGradedResponse(ctx, response, time, marks, answer); err != nil {
+		return SliderAnswerResponse{}, answerCounts, err
+	}
 
-	for _, o := range options {
-		oID, ok := o.(map[string]any)["id"].(string)
+	return SliderAnswerResponse{
+		Answers:   res,
+		Answer:    value,
+		Closeness: closeness,
+		Correct:   closeness == 1,
+		Marks:     &marks,
+		Time:      int(time),
+	}, answerCounts, nil
+}
+
+// sliderAnswers reads the target of a slider question.
+func sliderAnswers(answers []any) ([]SliderAnswer, error) {
+	res := make([]SliderAnswer, 0)
+	for _, a := range answers {
+		v, ok := a.(map[string]any)
 		if !ok {
-			return TextAnswerResponse{}, errors.New("invalid type assertion")
+			return nil, errors.New("invalid type assertion")
 		}
-		oContent, ok := o.(map[string]any)["content"].(string)
+		id, ok := v["id"].(string)
 		if !ok {
-			return TextAnswerResponse{}, errors.New("invalid type assertion")
+			return nil, errors.New("invalid type assertion")
 		}
-		for _, a := range answers {
-			aID, ok := a.(map[string]any)["id"].(string)
-			if !ok {
-				return TextAnswerResponse{}, errors.New("invalid type assertion")
-			}
-			aContent, ok := a.(map[string]any)["content"].(string)
-			if !ok {
-				return TextAnswerResponse{}, errors.New("invalid type assertion")
-			}
-			aCaseSensitive, ok := a.(map[string]any)["case_sensitive"].(bool)
-			if !ok {
-				return TextAnswerResponse{}, errors.New("invalid type assertion")
-			}
-			aM, ok := a.(map[string]any)["mark"].(float64)
-			if !ok {
-				return TextAnswerResponse{}, errors.New("invalid type assertion")
-			}
+		m, ok := v["mark"].(float64)
+		if !ok {
+			return nil, errors.New("invalid type assertion")
+		}
+		minValue, _ := v["min_value"].(float64)
+		maxValue, _ := v["max_value"].(float64)
+		step, _ := v["step"].(float64)
+		target, _ := v["target"].(float64)
+		tolerance, _ := v["tolerance"].(float64)
+		falloff, _ := v["falloff"].(float64)
+
+		res = append(res, SliderAnswer{
+			ID:        id,
+			MinValue:  minValue,
+			MaxValue:  maxValue,
+			Step:      step,
+			Target:    target,
+			Tolerance: tolerance,
+			Falloff:   falloff,
+			Mark:      int(m),
+		})
+	}
 
-			tb := 0.0
-			if aM > 0 {
-				tb = timeBonus
-			}
-			mark := int(math.Round(aM + tb))
+	return res, nil
+}
 
-			isCorrect := (aCaseSensitive && aContent == oContent) || (!aCaseSensitive && strings.EqualFold(aContent, oContent))
-			if oID == aID {
-				m := 0
-				if isCorrect {
-					marks += mark
-					m = mark
-				}
-				if status == util.Answering {
-					res = append(res, TextAnswer{
-						ID:            aID,
-						CaseSensitive: aCaseSensitive,
-						Content:       oContent,
-					})
-				}
-				if status == util.RevealingAnswer {
-					res = append(res, TextAnswer{
-						ID:            aID,
-						CaseSensitive: aCaseSensitive,
-						Content:       oContent,
-						Answer:        aContent,
-						Correct:       isCorrect,
-						Mark:          m,
-					})
-				}
-			}
+// gradeSlider snaps a slider answer onto the slider and scales the marks by
+// how close it is to the target. The best scoring target counts if there is
+// more than one.
+func gradeSlider(value *float64, answers []any, time float64, scoring Scoring) ([]SliderAnswer, *float64, float64, int, error) {
+	res, err := sliderAnswers(answers)
+	if err != nil {
+		return nil, value, 0, 0, err
+	}
+	if value != nil && (math.IsNaN(*value) || math.IsInf(*value, 0)) {
+		value = nil
+	}
+	if value == nil || len(res) == 0 {
+		marks := 0
+		if len(res) > 0 {
+			marks = scoring.penalty()
 		}
+		return res, value, 0, marks, nil
+	}
+
+	snapped := util.SnapToStep(*value, res[0].MinValue, res[0].MaxValue, res[0].Step)
+	closeness, mark := 0.0, 0.0
+	for _, a := range res {
+		c := util.Closeness(snapped, a.Target, a.MinValue, a.MaxValue, a.Tolerance, a.Falloff)
+		if c*float64(a.Mark) > mark || (mark == 0 && c > closeness) {
+			closeness, mark = c, c*float64(a.Mark)
+		}
+	}
+
+	marks := scoring.penalty()
+	if closeness > 0 {
+		marks = scoring.award(mark, time)
+	}
+
+	return res, &snapped, closeness, marks, nil
+}
+
+// sliderContent reads the value a participant submitted for a slider
+// question.
+func sliderContent(options any) *float64 {
+	v, ok := util.ParseNumber(numericContent(options), "")
+	if !ok {
+		return nil
 	}
+	return &v
+}
+
+func (s *service) CalculateHotspot(ctx context.Context, status string, click *util.Point, answers []any, time float64, scoring Scoring) (HotspotAnswerResponse, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
 
-	var mRes *int
 	if status == util.Answering {
-		mRes = nil
+		return HotspotAnswerResponse{
+			Click: click,
+			Marks: nil,
+			Time:  int(time),
+		}, nil
 	}
-	if status == util.RevealingAnswer {
-		mRes = &marks
+
+	res, marks, isCorrect, err := gradeHotspot(click, answers, time, scoring)
+	if err != nil {
+		return HotspotAnswerResponse{}, err
 	}
 
-	return TextAnswerResponse{
+	return HotspotAnswerResponse{
 		Answers: res,
-		Marks:   mRes,
+		Click:   click,
+		Correct: isCorrect,
+		Marks:   &marks,
 		Time:    int(time),
 	}, nil
 }
 
-func (s *service) CalculateAndSaveFillBlankResponse(ctx context.Context, options []any, answers []any, time float64, timeLimit float64, timeFactor float64, response *Response) (TextAnswerResponse, error) {
-	_, cancel := context.WithTimeout(context.Background(), s.timeout)
+func (s *service) CalculateAndSaveHotspotResponse(ctx context.Context, click *util.Point, answers []any, answerCounts map[string]int, time float64, scoring Scoring, response *Response) (HotspotAnswerResponse, map[string]int, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	timeLeft := (timeLimit * 10) - time
-	timeLeft /= 10
-	timeBonus := timeLeft * timeFactor
+	res, marks, isCorrect, err := gradeHotspot(click, answers, time, scoring)
+	if err != nil {
+		return HotspotAnswerResponse{}, answerCounts, err
+	}
+
+	answer := ""
+	if click != nil {
+		answer = strconv.FormatFloat(click.X, 'f', -1, 64) + "," + strconv.FormatFloat(click.Y, 'f', -1, 64)
+		answerCounts[answer] += 1
+	}
 
-	marks := 0
-	res := make([]TextAnswer, 0)
-	stringifyOptions := make([]string, len(options))
+	if err := s.saveGradedResponse(ctx, response, time, marks, answer); err != nil {
+		return HotspotAnswerResponse{}, answerCounts, err
+	}
 
-	for i, o := range options {
-		oID, ok := o.(map[string]any)["id"].(string)
+	return HotspotAnswerResponse{
+		Answers: res,
+		Click:   click,
+		Correct: isCorrect,
+		Marks:   &marks,
+		Time:    int(time),
+	}, answerCounts, nil
+}
+
+// hotspotAnswers reads the correct regions of a hotspot question.
+func hotspotAnswers(answers []any) ([]HotspotAnswer, error) {
+	res := make([]HotspotAnswer, 0)
+	for _, a := range answers {
+		v, ok := a.(map[string]any)
 		if !ok {
-			return TextAnswerResponse{}, errors.New("invalid type assertion")
+			return nil, errors.New("invalid type assertion")
 		}
-		oContent, ok := o.(map[string]any)["content"].(string)
+		id, ok := v["id"].(string)
 		if !ok {
-			return TextAnswerResponse{}, errors.New("invalid type assertion")
+			return nil, errors.New("invalid type assertion")
 		}
-		stringifyOptions[i] = string(oContent)
-		for _, a := range answers {
-			aID, ok := a.(map[string]any)["id"].(string)
-			if !ok {
-				return TextAnswerResponse{}, errors.New("invalid type assertion")
-			}
-			aContent, ok := a.(map[string]any)["content"].(string)
-			if !ok {
-				return TextAnswerResponse{}, errors.New("invalid type assertion")
-			}
-			aCaseSensitive, ok := a.(map[string]any)["case_sensitive"].(bool)
-			if !ok {
-				return TextAnswerResponse{}, errors.New("invalid type assertion")
-			}
-			aM, ok := a.(map[string]any)["mark"].(float64)
-			if !ok {
-				return TextAnswerResponse{}, errors.New("invalid type assertion")
-			}
-
-			tb := 0.0
-			if aM > 0 {
-				tb = timeBonus
-			}
-			mark := int(math.Round(aM + tb))
-
-			isCorrect := (aCaseSensitive && aContent == oContent) || (!aCaseSensitive && strings.EqualFold(aContent, oContent))
-			if oID == aID {
-				m := 0
-				if isCorrect {
-					marks += mark
-					m = mark
-				}
-				res = append(res, TextAnswer{
-					ID:            aID,
-					CaseSensitive: aCaseSensitive,
-					Content:       oContent,
-					Answer:        aContent,
-					Correct:       isCorrect,
-					Mark:          m,
-				})
-			}
+		m, ok := v["mark"].(float64)
+		if !ok {
+			return nil, errors.New("invalid type assertion")
 		}
+		shape, _ := v["shape"].(string)
+		x, _ := v["x"].(float64)
+		y, _ := v["y"].(float64)
+		width, _ := v["width"].(float64)
+		height, _ := v["height"].(float64)
+		radius, _ := v["radius"].(float64)
+		points, _ := v["points"].(string)
+
+		res = append(res, HotspotAnswer{
+			ID:     id,
+			Shape:  shape,
+			X:      x,
+			Y:      y,
+			Width:  width,
+			Height: height,
+			Radius: radius,
+			Points: util.ParsePoints(points),
+			Mark:   int(m),
+		})
 	}
 
-	p, err := s.GetParticipantByID(context.Background(), response.ParticipantID)
-	if err != nil {
-		return TextAnswerResponse{}, err
-	}
-	p.Marks += marks
-	_, err = s.UpdateParticipant(context.Background(), p)
+	return res, nil
+}
+
+// gradeHotspot checks a click against every correct region of the question
+// and awards the marks of the first one it falls into.
+func gradeHotspot(click *util.Point, answers []any, time float64, scoring Scoring) ([]HotspotAnswer, int, bool, error) {
+	res, err := hotspotAnswers(answers)
 	if err != nil {
-		return TextAnswerResponse{}, err
+		return nil, 0, false, err
 	}
 
-	stringifyAnswer := strings.Join(stringifyOptions, util.AnswerSplitter)
-	if _, err := s.SaveResponse(context.Background(), &Response{
-		ID:                response.ID,
-		LiveQuizSessionID: response.LiveQuizSessionID,
-		QuestionID:        response.QuestionID,
-		ParticipantID:     response.ParticipantID,
-		Type:              response.Type,
-		TimeTaken:         int(time),
-		Answer:            stringifyAnswer,
-	}); err != nil {
-		return TextAnswerResponse{}, err
+	marks := 0
+	isCorrect := false
+	if click != nil {
+		for _, a := range res {
+			region := util.Region{
+				Shape:  a.Shape,
+				X:      a.X,
+				Y:      a.Y,
+				Width:  a.Width,
+				Height: a.Height,
+				Radius: a.Radius,
+				Points: a.Points,
+			}
+			if region.Contains(*click) {
+				isCorrect = true
+				marks = scoring.award(float64(a.Mark), time)
+				break
+			}
+		}
+	}
+	if !isCorrect && len(res) > 0 {
+		marks = scoring.penalty()
 	}
 
-	return TextAnswerResponse{
-		Answers: res,
-		Marks:   &marks,
-		Time:    int(time),
-	}, nil
+	return res, marks, isCorrect, nil
 }
 
-func (s *service) CalculateParagraph(ctx context.Context, status string, content string, answers []any, time float64, timeLimit float64, timeFactor float64) (any, error) {
-	_, cancel := context.WithTimeout(ctx, s.timeout)
-	defer cancel()
-
-	timeLeft := (timeLimit * 10) - time
-	timeLeft /= 10
-	timeBonus := timeLeft * timeFactor
-
-	marks := 0
-	res := make([]TextAnswer, 0)
-
-	var r any
-	r = content
-	l := len(answers)
-	if l > 0 {
-		answer, ok := answers[0].(map[string]any)
+// hotspotContent reads where a participant clicked on the question image,
+// sent either as a point or as a pair of coordinates.
+func hotspotContent(options any) *util.Point {
+	switch v := options.(type) {
+	case map[string]any:
+		x, ok := v["x"].(float64)
 		if !ok {
-			return nil, errors.New("invalid type assertion")
+			return nil
 		}
-		aID, ok := answer["id"].(string)
+		y, ok := v["y"].(float64)
 		if !ok {
-			return nil, errors.New("invalid type assertion")
+			return nil
 		}
-		aContent, ok := answer["content"].(string)
-		if !ok {
-			return nil, errors.New("invalid type assertion")
+		return &util.Point{X: x, Y: y}
+	case []any:
+		if len(v) != 2 {
+			return nil
 		}
-		aCaseSensitive, ok := answer["case_sensitive"].(bool)
+		x, ok := v[0].(float64)
 		if !ok {
-			return nil, errors.New("invalid type assertion")
+			return nil
 		}
-		aM, ok := (answer["mark"].(float64))
+		y, ok := v[1].(float64)
 		if !ok {
-			return nil, errors.New("invalid type assertion")
+			return nil
 		}
+		return &util.Point{X: x, Y: y}
+	}
+	return nil
+}
 
-		tb := 0.0
-		if aM > 0 {
-			tb = timeBonus
+// hotspotClicks turns the counts of clicked spots into a list the host can
+// draw a heatmap from.
+func hotspotClicks(counts map[string]int) []HotspotClick {
+	res := make([]HotspotClick, 0, len(counts))
+	for k, c := range counts {
+		xy := strings.SplitN(k, ",", 2)
+		if len(xy) != 2 {
+			continue
 		}
-		mark := int(math.Round(aM + tb))
-
-		isCorrect := (aCaseSensitive && aContent == content) || (!aCaseSensitive && strings.EqualFold(aContent, content))
-		if isCorrect {
-			marks += mark
+		x, err := strconv.ParseFloat(xy[0], 64)
+		if err != nil {
+			continue
 		}
-		if status == util.RevealingAnswer {
-			res = append(res, TextAnswer{
-				ID:            aID,
-				CaseSensitive: aCaseSensitive,
-				Content:       content,
-				Answer:        aContent,
-				Correct:       isCorrect,
-				Mark:          mark,
-			})
-			r = TextAnswerResponse{
-				Answers: res,
-				Marks:   &marks,
-				Time:    int(time),
-			}
+		y, err := strconv.ParseFloat(xy[1], 64)
+		if err != nil {
+			continue
+		}
+		res = append(res, HotspotClick{
+			X:     x,
+			Y:     y,
+			Count: c,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].X != res[j].X {
+			return res[i].X < res[j].X
 		}
+		return res[i].Y < res[j].Y
+	})
+
+	return res
+}
+
+func (s *service) CalculateOrdering(ctx context.Context, status string, options []string, answers []any, time float64, scoring Scoring) (OrderingAnswerResponse, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, marks, isCorrect, err := gradeOrdering(options, answers, time, scoring)
+	if err != nil {
+		return OrderingAnswerResponse{}, err
 	}
 
-	var finalRes any
 	if status == util.Answering {
-		finalRes = ParagraphAnswerResponse{
-			Answer: content,
-			Marks:  nil,
-			Time:   int(time),
+		picked := make([]OrderingAnswer, len(options))
+		for i, id := range options {
+			picked[i] = OrderingAnswer{
+				ID:       id,
+				Position: i + 1,
+			}
 		}
-	}
-	if status == util.RevealingAnswer {
-		finalRes = r
+		return OrderingAnswerResponse{
+			Answers: picked,
+			Marks:   nil,
+			Time:    int(time),
+		}, nil
 	}
 
-	return finalRes, nil
+	return OrderingAnswerResponse{
+		Answers: res,
+		Correct: isCorrect,
+		Marks:   &marks,
+		Time:    int(time),
+	}, nil
 }
 
-func (s *service) CalculateAndSaveParagraphResponse(ctx context.Context, content string, answers []any, time float64, timeLimit float64, timeFactor float64, response *Response) (any, error) {
+func (s *service) CalculateAndSaveOrderingResponse(ctx context.Context, options []string, answers []any, time float64, scoring Scoring, response *Response) (OrderingAnswerResponse, error) {
 	_, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	timeLeft := (timeLimit * 10) - time
-	timeLeft /= 10
-	timeBonus := timeLeft * timeFactor
+	res, marks, isCorrect, err := gradeOrdering(options, answers, time, scoring)
+	if err != nil {
+		return OrderingAnswerResponse{}, err
+	}
 
-	marks := 0
-	res := make([]TextAnswer, 0)
+	if err := s.saveGradedResponse(ctx, response, time, marks, strings.Join(options, util.AnswerSplitter)); err != nil {
+		return OrderingAnswerResponse{}, err
+	}
 
-	var r any
-	r = content
-	l := len(answers)
-	if l > 0 {
-		answer, ok := answers[0].(map[string]any)
-		if !ok {
-			return nil, errors.New("invalid type assertion")
-		}
-		aID, ok := answer["id"].(string)
+	return OrderingAnswerResponse{
+		Answers: res,
+		Correct: isCorrect,
+		Marks:   &marks,
+		Time:    int(time),
+	}, nil
+}
+
+// orderingAnswers reads the items of an ordering question in their right
+// order.
+func orderingAnswers(answers []any) ([]OrderingAnswer, error) {
+	res := make([]OrderingAnswer, 0)
+	for _, a := range answers {
+		v, ok := a.(map[string]any)
 		if !ok {
 			return nil, errors.New("invalid type assertion")
 		}
-		aContent, ok := answer["content"].(string)
+		id, ok := v["id"].(string)
 		if !ok {
 			return nil, errors.New("invalid type assertion")
 		}
-		aCaseSensitive, ok := answer["case_sensitive"].(bool)
+		o, ok := v["order"].(float64)
 		if !ok {
 			return nil, errors.New("invalid type assertion")
 		}
-		aM, ok := (answer["mark"].(float64))
+		m, ok := v["mark"].(float64)
 		if !ok {
 			return nil, errors.New("invalid type assertion")
 		}
+		content, _ := v["content"].(string)
+		color, _ := v["color"].(string)
+
+		res = append(res, OrderingAnswer{
+			ID:      id,
+			Content: content,
+			Color:   color,
+			Order:   int(o),
+			Mark:    int(m),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Order < res[j].Order })
 
-		tb := 0.0
-		if aM > 0 {
-			tb = timeBonus
-		}
-		mark := int(math.Round(aM + tb))
+	return res, nil
+}
 
-		isCorrect := (aCaseSensitive && aContent == content) || (!aCaseSensitive && strings.EqualFold(aContent, content))
-		if isCorrect {
-			marks += mark
-		}
-		res = append(res, TextAnswer{
-			ID:            aID,
-			CaseSensitive: aCaseSensitive,
-			Content:       content,
-			Answer:        aContent,
-			Correct:       isCorrect,
-			Mark:          mark,
-		})
+// gradeOrdering checks where a participant put every item of an ordering
+// question. options are the item IDs in the order given.
+func gradeOrdering(options []string, answers []any, time float64, scoring Scoring) ([]OrderingAnswer, int, bool, error) {
+	res, err := orderingAnswers(answers)
+	if err != nil {
+		return nil, 0, false, err
+	}
 
-		r = TextAnswerResponse{
-			Answers: res,
-			Marks:   &marks,
-			Time:    int(time),
-		}
+	picked := make(map[string]int, len(options))
+	for i, id := range options {
+		picked[id] = i + 1
+	}
 
-		p, err := s.GetParticipantByID(context.Background(), response.ParticipantID)
-		if err != nil {
-			return TextAnswerResponse{}, err
+	items := make([]util.OrderItem, len(res))
+	for i, a := range res {
+		res[i].Position = picked[a.ID]
+		res[i].Correct = res[i].Position == a.Order
+		if !res[i].Correct {
+			res[i].Mark = 0
 		}
-		p.Marks += marks
-		_, err = s.UpdateParticipant(context.Background(), p)
-		if err != nil {
-			return TextAnswerResponse{}, err
+		items[i] = util.OrderItem{
+			Mark:     a.Mark,
+			Position: a.Order,
+			Picked:   picked[a.ID],
 		}
 	}
 
-	if _, err := s.SaveResponse(context.Background(), &Response{
-		ID:                response.ID,
-		LiveQuizSessionID: response.LiveQuizSessionID,
-		QuestionID:        response.QuestionID,
-		ParticipantID:     response.ParticipantID,
-		Type:              response.Type,
-		TimeTaken:         int(time),
-		Answer:            content,
-	}); err != nil {
-		return TextAnswerResponse{}, err
+	graded, isCorrect := util.GradeOrder(scoring.SelectGrading, items)
+	marks := 0
+	if graded > 0 {
+		marks = scoring.award(graded, time)
+	} else if len(res) > 0 {
+		marks = scoring.penalty()
 	}
 
-	return r, nil
+	return res, marks, isCorrect, nil
+}
+
+// orderingContent reads the item IDs a participant sent for an ordering
+// question, either as plain IDs or as items with an ID.
+func orderingContent(options any) []string {
+	opts, _ := options.([]any)
+	res := make([]string, 0, len(opts))
+	for _, o := range opts {
+		switch v := o.(type) {
+		case string:
+			res = append(res, v)
+		case map[string]any:
+			if id, ok := v["id"].(string); ok {
+				res = append(res, id)
+			}
+		}
+	}
+	return res
 }
 
-func (s *service) CalculateMatching(ctx context.Context, status string, options []any, answers []any, time float64, timeLimit float64, timeFactor float64) (MatchingAnswerResponse, error) {
+func (s *service) CalculateMatching(ctx context.Context, status string, options []any, answers []any, time float64, scoring Scoring) (MatchingAnswerResponse, error) {
 	_, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	timeLeft := (timeLimit * 10) - time
-	timeLeft /= 10
-	timeBonus := timeLeft * timeFactor
-
-	marks := 0
-	res := make([]MatchingAnswer, 0)
+	res, marks, err := gradeMatching(options, answers, time, scoring)
+	if err != nil {
+		return MatchingAnswerResponse{}, err
+	}
 
-	for _, o := range options {
-		oPrompt, ok := o.(map[string]any)["prompt"].(string)
-		if !ok {
-			return MatchingAnswerResponse{}, errors.New("invalid type assertion")
-		}
-		oOption, ok := o.(map[string]any)["option"].(string)
-		if !ok {
-			return MatchingAnswerResponse{}, errors.New("invalid type assertion")
-		}
-		for _, a := range answers {
-			aPrompt, ok := a.(map[string]any)["prompt_id"].(string)
-			if !ok {
-				return MatchingAnswerResponse{}, errors.New("invalid type assertion")
-			}
-			aOption, ok := a.(map[string]any)["option_id"].(string)
-			if !ok {
-				return MatchingAnswerResponse{}, errors.New("invalid type assertion")
-			}
-			aM, ok := a.(map[string]any)["mark"].(float64)
-			if !ok {
-				return MatchingAnswerResponse{}, errors.New("invalid type assertion")
+	switch status {
+	case util.Answering:
+		picked := make([]MatchingAnswer, len(res))
+		for i, r := range res {
+			picked[i] = MatchingAnswer{
+				PromptID: r.PromptID,
+				OptionID: r.OptionID,
 			}
+		}
+		return MatchingAnswerResponse{
+			Answers: picked,
+			Marks:   nil,
+			Time:    int(time),
+		}, nil
+	case util.RevealingAnswer:
+		return MatchingAnswerResponse{
+			Answers: res,
+			Marks:   &marks,
+			Time:    int(time),
+		}, nil
+	}
 
-			tb := 0.0
-			if aM > 0 {
-				tb = timeBonus
-			}
-			mark := int(math.Round(aM + tb))
+	return MatchingAnswerResponse{
+		Answers: make([]MatchingAnswer, 0),
+		Marks:   nil,
+		Time:    int(time),
+	}, nil
+}
 
-			isCorrect := oPrompt == aPrompt && oOption == aOption
-			if oPrompt == aPrompt {
-				m := 0
-				if isCorrect {
-					marks += mark
-					m = mark
-				}
-				if status == util.Answering {
-					res = append(res, MatchingAnswer{
-						PromptID: aPrompt,
-						OptionID: oOption,
-					})
-				}
-				if status == util.RevealingAnswer {
-					res = append(res, MatchingAnswer{
-						PromptID: aPrompt,
-						OptionID: oOption,
-						Correct:  isCorrect,
-						Mark:     m,
-					})
-				}
-			}
-		}
+func (s *service) CalculateAndSaveMatchingResponse(ctx context.Context, options []any, answers []any, time float64, scoring Scoring, response *Response) (MatchingAnswerResponse, error) {
+	_, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	res, marks, err := gradeMatching(options, answers, time, scoring)
+	if err != nil {
+		return MatchingAnswerResponse{}, err
 	}
 
-	var mRes *int
-	if status == util.Answering {
-		mRes = nil
+	pairs := make([]string, len(options))
+	for i, o := range options {
+		oPrompt, _ := o.(map[string]any)["prompt"].(string)
+		oOption, _ := o.(map[string]any)["option"].(string)
+		pairs[i] = oPrompt + ":" + oOption
 	}
-	if status == util.RevealingAnswer {
-		mRes = &marks
+
+	if err := s.saveGradedResponse(ctx, response, time, marks, strings.Join(pairs, util.AnswerSplitter)); err != nil {
+		return MatchingAnswerResponse{}, err
 	}
 
 	return MatchingAnswerResponse{
 		Answers: res,
-		Marks:   mRes,
+		Marks:   &marks,
 		Time:    int(time),
 	}, nil
 }
 
-func (s *service) CalculateAndSaveMatchingResponse(ctx context.Context, options []any, answers []any, time float64, timeLimit float64, timeFactor float64, response *Response) (MatchingAnswerResponse, error) {
-	_, cancel := context.WithTimeout(ctx, s.timeout)
-	defer cancel()
-
-	timeLeft := (timeLimit * 10) - time
-	timeLeft /= 10
-	timeBonus := timeLeft * timeFactor
-
+// gradeMatching checks the option a participant matched to every prompt.
+func gradeMatching(options []any, answers []any, time float64, scoring Scoring) ([]MatchingAnswer, int, error) {
 	marks := 0
 	res := make([]MatchingAnswer, 0)
-	stringifyOptions := make([]string, len(options))
 
-	for i, o := range options {
+	for _, o := range options {
 		oPrompt, ok := o.(map[string]any)["prompt"].(string)
 		if !ok {
-			return MatchingAnswerResponse{}, errors.New("invalid type assertion")
+			return nil, 0, errors.New("invalid type assertion")
 		}
 		oOption, ok := o.(map[string]any)["option"].(string)
 		if !ok {
-			return MatchingAnswerResponse{}, errors.New("invalid type assertion")
+			return nil, 0, errors.New("invalid type assertion")
 		}
-		stringifyOptions[i] = string(oPrompt + ":" + oOption)
 		for _, a := range answers {
 			aPrompt, ok := a.(map[string]any)["prompt_id"].(string)
 			if !ok {
-				return MatchingAnswerResponse{}, errors.New("invalid type assertion")
+				return nil, 0, errors.New("invalid type assertion")
 			}
 			aOption, ok := a.(map[string]any)["option_id"].(string)
 			if !ok {
-				return MatchingAnswerResponse{}, errors.New("invalid type assertion")
+				return nil, 0, errors.New("invalid type assertion")
 			}
 			aM, ok := a.(map[string]any)["mark"].(float64)
 			if !ok {
-				return MatchingAnswerResponse{}, errors.New("invalid type assertion")
+				return nil, 0, errors.New("invalid type assertion")
 			}
 
-			tb := 0.0
-			if aM > 0 {
-				tb = timeBonus
-			}
-			mark := int(math.Round(aM + tb))
+			mark := scoring.award(aM, time)
 
 			isCorrect := oPrompt == aPrompt && oOption == aOption
 			if oPrompt == aPrompt {
-				m := 0
+				m := scoring.penalty()
 				if isCorrect {
-					marks += mark
 					m = mark
 				}
+				marks += m
 				res = append(res, MatchingAnswer{
 					PromptID: aPrompt,
 					OptionID: oOption,
@@ -1394,34 +2659,7 @@ func (s *service) CalculateAndSaveMatchingResponse(ctx context.Context, options
 		}
 	}
 
-	p, err := s.GetParticipantByID(context.Background(), response.ParticipantID)
-	if err != nil {
-		return MatchingAnswerResponse{}, err
-	}
-	p.Marks += marks
-	_, err = s.UpdateParticipant(context.Background(), p)
-	if err != nil {
-		return MatchingAnswerResponse{}, err
-	}
-
-	stringifyAnswer := strings.Join(stringifyOptions, util.AnswerSplitter)
-	if _, err := s.SaveResponse(context.Background(), &Response{
-		ID:                response.ID,
-		LiveQuizSessionID: response.LiveQuizSessionID,
-		QuestionID:        response.QuestionID,
-		ParticipantID:     response.ParticipantID,
-		Type:              response.Type,
-		TimeTaken:         int(time),
-		Answer:            stringifyAnswer,
-	}); err != nil {
-		return MatchingAnswerResponse{}, err
-	}
-
-	return MatchingAnswerResponse{
-		Answers: res,
-		Marks:   &marks,
-		Time:    int(time),
-	}, nil
+	return res, marks, nil
 }
 
 // ---------- Leaderboard related service methods ---------- //
@@ -9,8 +9,14 @@ import (
 	"github.com/golang-jwt/jwt"
 )
 
+// LiveOptionalAuthentication also takes the access token from the
+// access_token query parameter, since browsers cannot set headers on a
+// WebSocket handshake.
 func LiveOptionalAuthentication(c *gin.Context) {
 	header := c.GetHeader("Authorization")
+	if (header == "" || header == "Bearer") && c.Query("access_token") != "" {
+		header = "Bearer " + c.Query("access_token")
+	}
 	if header == "" || header == "Bearer" {
 		c.Set("uid", "NOT_HOST")
 		return
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/Live-Quiz-Project/Backend/internal/util"
@@ -129,14 +130,34 @@ func (h *Handler) CreateQuiz(c *gin.Context) {
 						}
 						h.Service.CommitTransaction(c, txChoice)
 
+					} else if qRes.Type == util.Poll {
+						color, _ := qst["color"].(string)
+						txPoll, _ := h.Service.BeginTransaction(c)
+						_, err := h.Service.CreateChoiceOption(c, txPoll, &ChoiceOptionRequest{
+							ChoiceOption: ChoiceOption{
+								Order:   int(qst["order"].(float64)),
+								Content: qst["content"].(string),
+								Color:   color,
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
+						h.Service.CommitTransaction(c, txPoll)
+
 					} else if qRes.Type == util.FillBlank || qRes.Type == util.Paragraph {
+						matchMode, _ := qst["match_mode"].(string)
+						matchThreshold, _ := qst["match_threshold"].(float64)
 						txText, _ := h.Service.BeginTransaction(c)
 						_, err := h.Service.CreateTextOption(c, txText, &TextOptionRequest{
 							TextOption: TextOption{
-								Order:         int(qst["order"].(float64)),
-								Content:       qst["content"].(string),
-								Mark:          int(qst["mark"].(float64)),
-								CaseSensitive: qst["case_sensitive"].(bool),
+								Order:          int(qst["order"].(float64)),
+								Content:        qst["content"].(string),
+								Mark:           int(qst["mark"].(float64)),
+								CaseSensitive:  qst["case_sensitive"].(bool),
+								MatchMode:      matchMode,
+								MatchThreshold: matchThreshold,
 							},
 						}, qRes.ID, qRes.QuestionHistoryID, userID)
 
@@ -147,6 +168,108 @@ func (h *Handler) CreateQuiz(c *gin.Context) {
 
 						h.Service.CommitTransaction(c, txText)
 
+					} else if qRes.Type == util.Numeric {
+						value, _ := qst["value"].(float64)
+						tolerance, _ := qst["tolerance"].(float64)
+						minValue, _ := qst["min_value"].(float64)
+						maxValue, _ := qst["max_value"].(float64)
+						unit, _ := qst["unit"].(string)
+						txNumeric, _ := h.Service.BeginTransaction(c)
+						_, err := h.Service.CreateNumericOption(c, txNumeric, &NumericOptionRequest{
+							NumericOption: NumericOption{
+								Order:     int(qst["order"].(float64)),
+								Value:     value,
+								Tolerance: tolerance,
+								MinValue:  minValue,
+								MaxValue:  maxValue,
+								Unit:      unit,
+								Mark:      int(qst["mark"].(float64)),
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
+
+						h.Service.CommitTransaction(c, txNumeric)
+
+					} else if qRes.Type == util.Ordering {
+						color, _ := qst["color"].(string)
+						txOrdering, _ := h.Service.BeginTransaction(c)
+						_, err := h.Service.CreateOrderingOption(c, txOrdering, &OrderingOptionRequest{
+							OrderingOption: OrderingOption{
+								Order:   int(qst["order"].(float64)),
+								Content: qst["content"].(string),
+								Color:   color,
+								Mark:    int(qst["mark"].(float64)),
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
+
+						h.Service.CommitTransaction(c, txOrdering)
+
+					} else if qRes.Type == util.Hotspot {
+						shape, _ := qst["shape"].(string)
+						x, _ := qst["x"].(float64)
+						y, _ := qst["y"].(float64)
+						width, _ := qst["width"].(float64)
+						height, _ := qst["height"].(float64)
+						radius, _ := qst["radius"].(float64)
+						txHotspot, _ := h.Service.BeginTransaction(c)
+						_, err := h.Service.CreateHotspotOption(c, txHotspot, &HotspotOptionRequest{
+							HotspotOption: HotspotOption{
+								Order:  int(qst["order"].(float64)),
+								Shape:  shape,
+								X:      x,
+								Y:      y,
+								Width:  width,
+								Height: height,
+								Radius: radius,
+								Points: hotspotPoints(qst["points"]),
+								Mark:   int(qst["mark"].(float64)),
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
+
+						h.Service.CommitTransaction(c, txHotspot)
+
+					} else if qRes.Type == util.Slider {
+						minValue, _ := qst["min_value"].(float64)
+						maxValue, _ := qst["max_value"].(float64)
+						step, _ := qst["step"].(float64)
+						target, _ := qst["target"].(float64)
+						tolerance, _ := qst["tolerance"].(float64)
+						falloff, _ := qst["falloff"].(float64)
+						txSlider, _ := h.Service.BeginTransaction(c)
+						_, err := h.Service.CreateSliderOption(c, txSlider, &SliderOptionRequest{
+							SliderOption: SliderOption{
+								Order:     int(qst["order"].(float64)),
+								MinValue:  minValue,
+								MaxValue:  maxValue,
+								Step:      step,
+								Target:    target,
+								Tolerance: tolerance,
+								Falloff:   falloff,
+								Mark:      int(qst["mark"].(float64)),
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
+
+						h.Service.CommitTransaction(c, txSlider)
+
 					} else if qRes.Type == util.Matching {
 						txMatching, _ := h.Service.BeginTransaction(c)
 						if qst["type"].(string) != "MATCHING_ANSWER" {
@@ -232,6 +355,7 @@ func (h *Handler) CreateQuiz(c *gin.Context) {
 				LayoutIdx:      qRes.LayoutIdx,
 				SelectMin:      qRes.SelectMin,
 				SelectMax:      qRes.SelectMax,
+				SelectGrading:  qRes.SelectGrading,
 				CreatedAt:      qRes.CreatedAt,
 				UpdatedAt:      qRes.UpdatedAt,
 				DeletedAt:      qRes.DeletedAt,
@@ -255,6 +379,7 @@ func (h *Handler) CreateQuiz(c *gin.Context) {
 			Mark:           res.Mark,
 			SelectMin:      res.SelectMin,
 			SelectMax:      res.SelectMax,
+			SelectGrading:  res.SelectGrading,
 			CaseSensitive:  res.CaseSensitive,
 			CreatedAt:      res.CreatedAt,
 			UpdatedAt:      res.UpdatedAt,
@@ -462,15 +587,46 @@ func (h *Handler) UpdateQuiz(c *gin.Context) {
 							}
 						}
 
+					} else if qRes.Type == util.Poll {
+						color, _ := qst["color"].(string)
+						pollReq := ChoiceOptionRequest{
+							ChoiceOption: ChoiceOption{
+								ID:         id,
+								QuestionID: questionID,
+								Order:      int(qst["order"].(float64)),
+								Content:    qst["content"].(string),
+								Color:      color,
+							},
+						}
+
+						if pollReq.ID != uuid.Nil {
+							_, err := h.Service.UpdateChoiceOption(c, tx, &pollReq, userID, id, qRes.QuestionHistoryID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+
+						} else {
+							_, err := h.Service.CreateChoiceOption(c, tx, &pollReq, qRes.ID, qRes.QuestionHistoryID, userID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+						}
+
 					} else if qRes.Type == util.FillBlank || qRes.Type == util.Paragraph {
+						matchMode, _ := qst["match_mode"].(string)
+						matchThreshold, _ := qst["match_threshold"].(float64)
 						textReq := TextOptionRequest{
 							TextOption: TextOption{
-								ID:            id,
-								QuestionID:    questionID,
-								Order:         int(qst["order"].(float64)),
-								Content:       qst["content"].(string),
-								Mark:          int(qst["mark"].(float64)),
-								CaseSensitive: qst["case_sensitive"].(bool),
+								ID:             id,
+								QuestionID:     questionID,
+								Order:          int(qst["order"].(float64)),
+								Content:        qst["content"].(string),
+								Mark:           int(qst["mark"].(float64)),
+								CaseSensitive:  qst["case_sensitive"].(bool),
+								MatchMode:      matchMode,
+								MatchThreshold: matchThreshold,
 							},
 						}
 
@@ -488,6 +644,140 @@ func (h *Handler) UpdateQuiz(c *gin.Context) {
 								return
 							}
 						}
+					} else if qRes.Type == util.Numeric {
+						value, _ := qst["value"].(float64)
+						tolerance, _ := qst["tolerance"].(float64)
+						minValue, _ := qst["min_value"].(float64)
+						maxValue, _ := qst["max_value"].(float64)
+						unit, _ := qst["unit"].(string)
+						numericReq := NumericOptionRequest{
+							NumericOption: NumericOption{
+								ID:         id,
+								QuestionID: questionID,
+								Order:      int(qst["order"].(float64)),
+								Value:      value,
+								Tolerance:  tolerance,
+								MinValue:   minValue,
+								MaxValue:   maxValue,
+								Unit:       unit,
+								Mark:       int(qst["mark"].(float64)),
+							},
+						}
+
+						if numericReq.ID != uuid.Nil {
+							_, err := h.Service.UpdateNumericOption(c, tx, &numericReq, userID, id, qRes.QuestionHistoryID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+
+						} else {
+							_, err := h.Service.CreateNumericOption(c, tx, &numericReq, qRes.ID, qRes.QuestionHistoryID, userID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+						}
+					} else if qRes.Type == util.Ordering {
+						color, _ := qst["color"].(string)
+						orderingReq := OrderingOptionRequest{
+							OrderingOption: OrderingOption{
+								ID:         id,
+								QuestionID: questionID,
+								Order:      int(qst["order"].(float64)),
+								Content:    qst["content"].(string),
+								Color:      color,
+								Mark:       int(qst["mark"].(float64)),
+							},
+						}
+
+						if orderingReq.ID != uuid.Nil {
+							_, err := h.Service.UpdateOrderingOption(c, tx, &orderingReq, userID, id, qRes.QuestionHistoryID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+
+						} else {
+							_, err := h.Service.CreateOrderingOption(c, tx, &orderingReq, qRes.ID, qRes.QuestionHistoryID, userID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+						}
+					} else if qRes.Type == util.Hotspot {
+						shape, _ := qst["shape"].(string)
+						x, _ := qst["x"].(float64)
+						y, _ := qst["y"].(float64)
+						width, _ := qst["width"].(float64)
+						height, _ := qst["height"].(float64)
+						radius, _ := qst["radius"].(float64)
+						hotspotReq := HotspotOptionRequest{
+							HotspotOption: HotspotOption{
+								ID:         id,
+								QuestionID: questionID,
+								Order:      int(qst["order"].(float64)),
+								Shape:      shape,
+								X:          x,
+								Y:          y,
+								Width:      width,
+								Height:     height,
+								Radius:     radius,
+								Points:     hotspotPoints(qst["points"]),
+								Mark:       int(qst["mark"].(float64)),
+							},
+						}
+
+						if hotspotReq.ID != uuid.Nil {
+							_, err := h.Service.UpdateHotspotOption(c, tx, &hotspotReq, userID, id, qRes.QuestionHistoryID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+
+						} else {
+							_, err := h.Service.CreateHotspotOption(c, tx, &hotspotReq, qRes.ID, qRes.QuestionHistoryID, userID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+						}
+					} else if qRes.Type == util.Slider {
+						minValue, _ := qst["min_value"].(float64)
+						maxValue, _ := qst["max_value"].(float64)
+						step, _ := qst["step"].(float64)
+						target, _ := qst["target"].(float64)
+						tolerance, _ := qst["tolerance"].(float64)
+						falloff, _ := qst["falloff"].(float64)
+						sliderReq := SliderOptionRequest{
+							SliderOption: SliderOption{
+								ID:         id,
+								QuestionID: questionID,
+								Order:      int(qst["order"].(float64)),
+								MinValue:   minValue,
+								MaxValue:   maxValue,
+								Step:       step,
+								Target:     target,
+								Tolerance:  tolerance,
+								Falloff:    falloff,
+								Mark:       int(qst["mark"].(float64)),
+							},
+						}
+
+						if sliderReq.ID != uuid.Nil {
+							_, err := h.Service.UpdateSliderOption(c, tx, &sliderReq, userID, id, qRes.QuestionHistoryID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+
+						} else {
+							_, err := h.Service.CreateSliderOption(c, tx, &sliderReq, qRes.ID, qRes.QuestionHistoryID, userID)
+							if err != nil {
+								c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+								return
+							}
+						}
 					} else if qRes.Type == util.Matching {
 						if qst["type"].(string) != "MATCHING_ANSWER" {
 							matchingOptionReq := MatchingOptionRequest{
@@ -596,6 +886,7 @@ func (h *Handler) UpdateQuiz(c *gin.Context) {
 					LayoutIdx:      qRes.LayoutIdx,
 					SelectMin:      qRes.SelectMin,
 					SelectMax:      qRes.SelectMax,
+					SelectGrading:  qRes.SelectGrading,
 					CreatedAt:      qRes.CreatedAt,
 					UpdatedAt:      qRes.UpdatedAt,
 					DeletedAt:      qRes.DeletedAt,
@@ -649,13 +940,112 @@ func (h *Handler) UpdateQuiz(c *gin.Context) {
 							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 							return
 						}
+					} else if qRes.Type == util.Poll {
+						color, _ := qst["color"].(string)
+						_, err := h.Service.CreateChoiceOption(c, tx, &ChoiceOptionRequest{
+							ChoiceOption: ChoiceOption{
+								Order:   int(qst["order"].(float64)),
+								Content: qst["content"].(string),
+								Color:   color,
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
 					} else if qRes.Type == util.FillBlank || qRes.Type == util.Paragraph {
+						matchMode, _ := qst["match_mode"].(string)
+						matchThreshold, _ := qst["match_threshold"].(float64)
 						_, err := h.Service.CreateTextOption(c, tx, &TextOptionRequest{
 							TextOption: TextOption{
-								Order:         int(qst["order"].(float64)),
-								Content:       qst["content"].(string),
-								Mark:          int(qst["mark"].(float64)),
-								CaseSensitive: qst["case_sensitive"].(bool),
+								Order:          int(qst["order"].(float64)),
+								Content:        qst["content"].(string),
+								Mark:           int(qst["mark"].(float64)),
+								CaseSensitive:  qst["case_sensitive"].(bool),
+								MatchMode:      matchMode,
+								MatchThreshold: matchThreshold,
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
+					} else if qRes.Type == util.Numeric {
+						value, _ := qst["value"].(float64)
+						tolerance, _ := qst["tolerance"].(float64)
+						minValue, _ := qst["min_value"].(float64)
+						maxValue, _ := qst["max_value"].(float64)
+						unit, _ := qst["unit"].(string)
+						_, err := h.Service.CreateNumericOption(c, tx, &NumericOptionRequest{
+							NumericOption: NumericOption{
+								Order:     int(qst["order"].(float64)),
+								Value:     value,
+								Tolerance: tolerance,
+								MinValue:  minValue,
+								MaxValue:  maxValue,
+								Unit:      unit,
+								Mark:      int(qst["mark"].(float64)),
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
+					} else if qRes.Type == util.Ordering {
+						color, _ := qst["color"].(string)
+						_, err := h.Service.CreateOrderingOption(c, tx, &OrderingOptionRequest{
+							OrderingOption: OrderingOption{
+								Order:   int(qst["order"].(float64)),
+								Content: qst["content"].(string),
+								Color:   color,
+								Mark:    int(qst["mark"].(float64)),
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
+					} else if qRes.Type == util.Hotspot {
+						shape, _ := qst["shape"].(string)
+						x, _ := qst["x"].(float64)
+						y, _ := qst["y"].(float64)
+						width, _ := qst["width"].(float64)
+						height, _ := qst["height"].(float64)
+						radius, _ := qst["radius"].(float64)
+						_, err := h.Service.CreateHotspotOption(c, tx, &HotspotOptionRequest{
+							HotspotOption: HotspotOption{
+								Order:  int(qst["order"].(float64)),
+								Shape:  shape,
+								X:      x,
+								Y:      y,
+								Width:  width,
+								Height: height,
+								Radius: radius,
+								Points: hotspotPoints(qst["points"]),
+								Mark:   int(qst["mark"].(float64)),
+							},
+						}, qRes.ID, qRes.QuestionHistoryID, userID)
+						if err != nil {
+							c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+							return
+						}
+					} else if qRes.Type == util.Slider {
+						minValue, _ := qst["min_value"].(float64)
+						maxValue, _ := qst["max_value"].(float64)
+						step, _ := qst["step"].(float64)
+						target, _ := qst["target"].(float64)
+						tolerance, _ := qst["tolerance"].(float64)
+						falloff, _ := qst["falloff"].(float64)
+						_, err := h.Service.CreateSliderOption(c, tx, &SliderOptionRequest{
+							SliderOption: SliderOption{
+								Order:     int(qst["order"].(float64)),
+								MinValue:  minValue,
+								MaxValue:  maxValue,
+								Step:      step,
+								Target:    target,
+								Tolerance: tolerance,
+								Falloff:   falloff,
+								Mark:      int(qst["mark"].(float64)),
 							},
 						}, qRes.ID, qRes.QuestionHistoryID, userID)
 						if err != nil {
@@ -742,6 +1132,7 @@ func (h *Handler) UpdateQuiz(c *gin.Context) {
 					LayoutIdx:      qRes.LayoutIdx,
 					SelectMin:      qRes.SelectMin,
 					SelectMax:      qRes.SelectMax,
+					SelectGrading:  qRes.SelectGrading,
 					CreatedAt:      qRes.CreatedAt,
 					UpdatedAt:      qRes.UpdatedAt,
 					DeletedAt:      qRes.DeletedAt,
@@ -770,6 +1161,7 @@ func (h *Handler) UpdateQuiz(c *gin.Context) {
 			Mark:           res.Mark,
 			SelectMin:      res.SelectMin,
 			SelectMax:      res.SelectMax,
+			SelectGrading:  res.SelectGrading,
 			CaseSensitive:  res.CaseSensitive,
 			CreatedAt:      res.CreatedAt,
 			UpdatedAt:      res.UpdatedAt,
@@ -822,7 +1214,7 @@ func (h *Handler) DeleteQuiz(c *gin.Context) {
 
 	for _, question := range questionData {
 
-		if question.Type == util.Choice || question.Type == util.TrueFalse {
+		if question.Type == util.Choice || question.Type == util.TrueFalse || question.Type == util.Poll {
 			choiceOptionData, err := h.Service.GetChoiceOptionsByQuestionID(c, question.ID)
 			if err != nil {
 				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -855,6 +1247,70 @@ func (h *Handler) DeleteQuiz(c *gin.Context) {
 			}
 		}
 
+		if question.Type == util.Numeric {
+			numericOptionData, err := h.Service.GetNumericOptionsByQuestionID(c, question.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			for _, numeric := range numericOptionData {
+				err := h.Service.DeleteNumericOption(c, tx, numeric.ID)
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+			}
+		}
+
+		if question.Type == util.Ordering {
+			orderingOptionData, err := h.Service.GetOrderingOptionsByQuestionID(c, question.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			for _, ordering := range orderingOptionData {
+				err := h.Service.DeleteOrderingOption(c, tx, ordering.ID)
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+			}
+		}
+
+		if question.Type == util.Hotspot {
+			hotspotOptionData, err := h.Service.GetHotspotOptionsByQuestionID(c, question.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			for _, hotspot := range hotspotOptionData {
+				err := h.Service.DeleteHotspotOption(c, tx, hotspot.ID)
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+			}
+		}
+
+		if question.Type == util.Slider {
+			sliderOptionData, err := h.Service.GetSliderOptionsByQuestionID(c, question.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			for _, slider := range sliderOptionData {
+				err := h.Service.DeleteSliderOption(c, tx, slider.ID)
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+			}
+		}
+
 		if question.Type == util.Matching {
 			matchingOptionData, err := h.Service.GetMatchingOptionsByQuestionID(c, question.ID)
 			if err != nil {
@@ -947,7 +1403,7 @@ func (h *Handler) RestoreQuiz(c *gin.Context) {
 
 	for _, question := range questionData {
 
-		if question.Type == util.Choice || question.Type == util.TrueFalse {
+		if question.Type == util.Choice || question.Type == util.TrueFalse || question.Type == util.Poll {
 			choiceOptionData, err := h.Service.GetDeleteChoiceOptionsByQuestionID(c, question.ID)
 			if err != nil {
 				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -980,6 +1436,70 @@ func (h *Handler) RestoreQuiz(c *gin.Context) {
 			}
 		}
 
+		if question.Type == util.Numeric {
+			numericOptionData, err := h.Service.GetDeleteNumericOptionsByQuestionID(c, question.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			for _, numeric := range numericOptionData {
+				err := h.Service.RestoreNumericOption(c, tx, numeric.ID)
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+			}
+		}
+
+		if question.Type == util.Ordering {
+			orderingOptionData, err := h.Service.GetDeleteOrderingOptionsByQuestionID(c, question.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			for _, ordering := range orderingOptionData {
+				err := h.Service.RestoreOrderingOption(c, tx, ordering.ID)
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+			}
+		}
+
+		if question.Type == util.Hotspot {
+			hotspotOptionData, err := h.Service.GetDeleteHotspotOptionsByQuestionID(c, question.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			for _, hotspot := range hotspotOptionData {
+				err := h.Service.RestoreHotspotOption(c, tx, hotspot.ID)
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+			}
+		}
+
+		if question.Type == util.Slider {
+			sliderOptionData, err := h.Service.GetDeleteSliderOptionsByQuestionID(c, question.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+
+			for _, slider := range sliderOptionData {
+				err := h.Service.RestoreSliderOption(c, tx, slider.ID)
+				if err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+			}
+		}
+
 		if question.Type == util.Matching {
 			matchingOptionData, err := h.Service.GetDeleteMatchingOptionsByQuestionID(c, question.ID)
 			if err != nil {
@@ -1081,3 +1601,19 @@ func (h *Handler) GetQuizHistoryByID(c *gin.Context) {
 
 	c.JSON(http.StatusOK, res)
 }
+
+// hotspotPoints stores the vertices of a polygon region as JSON, whether they
+// are sent as a list of points or already as text.
+func hotspotPoints(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+	return ""
+}
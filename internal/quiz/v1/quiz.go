@@ -23,6 +23,7 @@ type Quiz struct {
 	Mark           int            `json:"mark" gorm:"column:mark;type:int"`
 	SelectMin      int            `json:"select_min" gorm:"column:select_min;type:int"`
 	SelectMax      int            `json:"select_max" gorm:"column:select_max;type:int"`
+	SelectGrading  string         `json:"select_grading" gorm:"column:select_grading;type:text"`
 	CaseSensitive  bool           `json:"case_sensitive" gorm:"column:case_sensitive;type:boolean"`
 	CreatedAt      time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
 	UpdatedAt      time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
@@ -48,6 +49,7 @@ type QuizHistory struct {
 	Mark           int            `json:"mark" gorm:"column:mark;type:int"`
 	SelectMin      int            `json:"select_min" gorm:"column:select_min;type:int"`
 	SelectMax      int            `json:"select_max" gorm:"column:select_max;type:int"`
+	SelectGrading  string         `json:"select_grading" gorm:"column:select_grading;type:text"`
 	CaseSensitive  bool           `json:"case_sensitive" gorm:"column:case_sensitive;type:boolean"`
 	CreatedAt      time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
 	UpdatedAt      time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
@@ -125,6 +127,7 @@ type Question struct {
 	LayoutIdx      int            `json:"layout_idx" gorm:"column:layout_idx;type:int"`
 	SelectMin      int            `json:"select_min" gorm:"column:select_min;type:int"`
 	SelectMax      int            `json:"select_max" gorm:"column:select_max;type:int"`
+	SelectGrading  string         `json:"select_grading" gorm:"column:select_grading;type:text"`
 	CreatedAt      time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
 	UpdatedAt      time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
 	DeletedAt      gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
@@ -155,6 +158,7 @@ type QuestionHistory struct {
 	LayoutIdx      int            `json:"layout_idx" gorm:"column:layout_idx;type:int"`
 	SelectMin      int            `json:"select_min" gorm:"column:select_min;type:int"`
 	SelectMax      int            `json:"select_max" gorm:"column:select_max;type:int"`
+	SelectGrading  string         `json:"select_grading" gorm:"column:select_grading;type:text"`
 	CreatedAt      time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
 	UpdatedAt      time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
 	DeletedAt      gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
@@ -203,15 +207,17 @@ func (ChoiceOptionHistory) TableName() string {
 
 // Text related models
 type TextOption struct {
-	ID            uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
-	QuestionID    uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question(id)"`
-	Order         int            `json:"order" gorm:"column:order;type:int"`
-	Content       string         `json:"content" gorm:"column:content;type:text"`
-	Mark          int            `json:"mark" gorm:"column:mark;type:int"`
-	CaseSensitive bool           `json:"case_sensitive" gorm:"column:case_sensitive;type:boolean"`
-	CreatedAt     time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
-	UpdatedAt     time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
-	DeletedAt     gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+	ID             uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	QuestionID     uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question(id)"`
+	Order          int            `json:"order" gorm:"column:order;type:int"`
+	Content        string         `json:"content" gorm:"column:content;type:text"`
+	Mark           int            `json:"mark" gorm:"column:mark;type:int"`
+	CaseSensitive  bool           `json:"case_sensitive" gorm:"column:case_sensitive;type:boolean"`
+	MatchMode      string         `json:"match_mode" gorm:"column:match_mode;type:text"`
+	MatchThreshold float64        `json:"match_threshold" gorm:"column:match_threshold;type:float"`
+	CreatedAt      time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt      time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt      gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
 }
 
 func (TextOption) TableName() string {
@@ -219,20 +225,182 @@ func (TextOption) TableName() string {
 }
 
 type TextOptionHistory struct {
+	ID             uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	OptionTextID   uuid.UUID      `json:"option_text_id" gorm:"column:option_text_id;type:uuid;not null;references:option_text(id)"`
+	QuestionID     uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question_history(id)"`
+	Order          int            `json:"order" gorm:"column:order;type:int"`
+	Content        string         `json:"content" gorm:"column:content;type:text"`
+	Mark           int            `json:"mark" gorm:"column:mark;type:int"`
+	CaseSensitive  bool           `json:"case_sensitive" gorm:"column:case_sensitive;type:boolean"`
+	MatchMode      string         `json:"match_mode" gorm:"column:match_mode;type:text"`
+	MatchThreshold float64        `json:"match_threshold" gorm:"column:match_threshold;type:float"`
+	CreatedAt      time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt      time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt      gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+}
+
+func (TextOptionHistory) TableName() string {
+	return "option_text_history"
+}
+
+// Numeric related models
+type NumericOption struct {
+	ID         uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	QuestionID uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question(id)"`
+	Order      int            `json:"order" gorm:"column:order;type:int"`
+	Value      float64        `json:"value" gorm:"column:value;type:float"`
+	Tolerance  float64        `json:"tolerance" gorm:"column:tolerance;type:float"`
+	MinValue   float64        `json:"min_value" gorm:"column:min_value;type:float"`
+	MaxValue   float64        `json:"max_value" gorm:"column:max_value;type:float"`
+	Unit       string         `json:"unit" gorm:"column:unit;type:text"`
+	Mark       int            `json:"mark" gorm:"column:mark;type:int"`
+	CreatedAt  time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt  time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt  gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+}
+
+func (NumericOption) TableName() string {
+	return "option_numeric"
+}
+
+type NumericOptionHistory struct {
+	ID              uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	OptionNumericID uuid.UUID      `json:"option_numeric_id" gorm:"column:option_numeric_id;type:uuid;not null;references:option_numeric(id)"`
+	QuestionID      uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question_history(id)"`
+	Order           int            `json:"order" gorm:"column:order;type:int"`
+	Value           float64        `json:"value" gorm:"column:value;type:float"`
+	Tolerance       float64        `json:"tolerance" gorm:"column:tolerance;type:float"`
+	MinValue        float64        `json:"min_value" gorm:"column:min_value;type:float"`
+	MaxValue        float64        `json:"max_value" gorm:"column:max_value;type:float"`
+	Unit            string         `json:"unit" gorm:"column:unit;type:text"`
+	Mark            int            `json:"mark" gorm:"column:mark;type:int"`
+	CreatedAt       time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt       time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt       gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+}
+
+func (NumericOptionHistory) TableName() string {
+	return "option_numeric_history"
+}
+
+// Ordering related models
+type OrderingOption struct {
+	ID         uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	QuestionID uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question(id)"`
+	Order      int            `json:"order" gorm:"column:order;type:int"`
+	Content    string         `json:"content" gorm:"column:content;type:text"`
+	Color      string         `json:"color" gorm:"column:color;type:text"`
+	Mark       int            `json:"mark" gorm:"column:mark;type:int"`
+	CreatedAt  time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt  time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt  gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+}
+
+func (OrderingOption) TableName() string {
+	return "option_order"
+}
+
+type OrderingOptionHistory struct {
 	ID            uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
-	OptionTextID  uuid.UUID      `json:"option_text_id" gorm:"column:option_text_id;type:uuid;not null;references:option_text(id)"`
+	OptionOrderID uuid.UUID      `json:"option_order_id" gorm:"column:option_order_id;type:uuid;not null;references:option_order(id)"`
 	QuestionID    uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question_history(id)"`
 	Order         int            `json:"order" gorm:"column:order;type:int"`
 	Content       string         `json:"content" gorm:"column:content;type:text"`
+	Color         string         `json:"color" gorm:"column:color;type:text"`
 	Mark          int            `json:"mark" gorm:"column:mark;type:int"`
-	CaseSensitive bool           `json:"case_sensitive" gorm:"column:case_sensitive;type:boolean"`
 	CreatedAt     time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
 	UpdatedAt     time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
 	DeletedAt     gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
 }
 
-func (TextOptionHistory) TableName() string {
-	return "option_text_history"
+func (OrderingOptionHistory) TableName() string {
+	return "option_order_history"
+}
+
+// Slider related models
+type SliderOption struct {
+	ID         uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	QuestionID uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question(id)"`
+	Order      int            `json:"order" gorm:"column:order;type:int"`
+	MinValue   float64        `json:"min_value" gorm:"column:min_value;type:float"`
+	MaxValue   float64        `json:"max_value" gorm:"column:max_value;type:float"`
+	Step       float64        `json:"step" gorm:"column:step;type:float"`
+	Target     float64        `json:"target" gorm:"column:target;type:float"`
+	Tolerance  float64        `json:"tolerance" gorm:"column:tolerance;type:float"`
+	Falloff    float64        `json:"falloff" gorm:"column:falloff;type:float"`
+	Mark       int            `json:"mark" gorm:"column:mark;type:int"`
+	CreatedAt  time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt  time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt  gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+}
+
+func (SliderOption) TableName() string {
+	return "option_slider"
+}
+
+type SliderOptionHistory struct {
+	ID             uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	OptionSliderID uuid.UUID      `json:"option_slider_id" gorm:"column:option_slider_id;type:uuid;not null;references:option_slider(id)"`
+	QuestionID     uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question_history(id)"`
+	Order          int            `json:"order" gorm:"column:order;type:int"`
+	MinValue       float64        `json:"min_value" gorm:"column:min_value;type:float"`
+	MaxValue       float64        `json:"max_value" gorm:"column:max_value;type:float"`
+	Step           float64        `json:"step" gorm:"column:step;type:float"`
+	Target         float64        `json:"target" gorm:"column:target;type:float"`
+	Tolerance      float64        `json:"tolerance" gorm:"column:tolerance;type:float"`
+	Falloff        float64        `json:"falloff" gorm:"column:falloff;type:float"`
+	Mark           int            `json:"mark" gorm:"column:mark;type:int"`
+	CreatedAt      time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt      time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt      gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+}
+
+func (SliderOptionHistory) TableName() string {
+	return "option_slider_history"
+}
+
+// Hotspot related models
+type HotspotOption struct {
+	ID         uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	QuestionID uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question(id)"`
+	Order      int            `json:"order" gorm:"column:order;type:int"`
+	Shape      string         `json:"shape" gorm:"column:shape;type:text"`
+	X          float64        `json:"x" gorm:"column:x;type:float"`
+	Y          float64        `json:"y" gorm:"column:y;type:float"`
+	Width      float64        `json:"width" gorm:"column:width;type:float"`
+	Height     float64        `json:"height" gorm:"column:height;type:float"`
+	Radius     float64        `json:"radius" gorm:"column:radius;type:float"`
+	Points     string         `json:"points" gorm:"column:points;type:text"`
+	Mark       int            `json:"mark" gorm:"column:mark;type:int"`
+	CreatedAt  time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt  time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt  gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+}
+
+func (HotspotOption) TableName() string {
+	return "option_hotspot"
+}
+
+type HotspotOptionHistory struct {
+	ID              uuid.UUID      `json:"id" gorm:"column:id;type:uuid;primaryKey;not null"`
+	OptionHotspotID uuid.UUID      `json:"option_hotspot_id" gorm:"column:option_hotspot_id;type:uuid;not null;references:option_hotspot(id)"`
+	QuestionID      uuid.UUID      `json:"question_id" gorm:"column:question_id;type:uuid;not null;references:question_history(id)"`
+	Order           int            `json:"order" gorm:"column:order;type:int"`
+	Shape           string         `json:"shape" gorm:"column:shape;type:text"`
+	X               float64        `json:"x" gorm:"column:x;type:float"`
+	Y               float64        `json:"y" gorm:"column:y;type:float"`
+	Width           float64        `json:"width" gorm:"column:width;type:float"`
+	Height          float64        `json:"height" gorm:"column:height;type:float"`
+	Radius          float64        `json:"radius" gorm:"column:radius;type:float"`
+	Points          string         `json:"points" gorm:"column:points;type:text"`
+	Mark            int            `json:"mark" gorm:"column:mark;type:int"`
+	CreatedAt       time.Time      `json:"created_at" gorm:"column:created_at;type:timestamp;not null"`
+	UpdatedAt       time.Time      `json:"updated_at" gorm:"column:updated_at;type:timestamp;not null"`
+	DeletedAt       gorm.DeletedAt `json:"deleted_at" gorm:"column:deleted_at;type:timestamp"`
+}
+
+func (HotspotOptionHistory) TableName() string {
+	return "option_hotspot_history"
 }
 
 // Matching related models
@@ -392,6 +560,62 @@ type Repository interface {
 	UpdateTextOptionHistory(ctx context.Context, tx *gorm.DB, optionTextHistory *TextOptionHistory) (*TextOptionHistory, error)
 	DeleteTextOptionHistory(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
 
+	// Numeric related repository methods
+	CreateNumericOption(ctx context.Context, tx *gorm.DB, optionNumeric *NumericOption) (*NumericOption, error)
+	GetNumericOptionByID(ctx context.Context, id uuid.UUID) (*NumericOption, error)
+	GetNumericOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOption, error)
+	GetDeleteNumericOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOption, error)
+	UpdateNumericOption(ctx context.Context, tx *gorm.DB, optionNumeric *NumericOption) (*NumericOption, error)
+	DeleteNumericOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+	RestoreNumericOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*NumericOption, error)
+	CreateNumericOptionHistory(ctx context.Context, tx *gorm.DB, optionNumericHistory *NumericOptionHistory) (*NumericOptionHistory, error)
+	GetNumericOptionHistoryByID(ctx context.Context, id uuid.UUID) (*NumericOptionHistory, error)
+	GetNumericOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOptionHistory, error)
+	UpdateNumericOptionHistory(ctx context.Context, tx *gorm.DB, optionNumericHistory *NumericOptionHistory) (*NumericOptionHistory, error)
+	DeleteNumericOptionHistory(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+
+	// Ordering related repository methods
+	CreateOrderingOption(ctx context.Context, tx *gorm.DB, optionOrdering *OrderingOption) (*OrderingOption, error)
+	GetOrderingOptionByID(ctx context.Context, id uuid.UUID) (*OrderingOption, error)
+	GetOrderingOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOption, error)
+	GetDeleteOrderingOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOption, error)
+	UpdateOrderingOption(ctx context.Context, tx *gorm.DB, optionOrdering *OrderingOption) (*OrderingOption, error)
+	DeleteOrderingOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+	RestoreOrderingOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*OrderingOption, error)
+	CreateOrderingOptionHistory(ctx context.Context, tx *gorm.DB, optionOrderingHistory *OrderingOptionHistory) (*OrderingOptionHistory, error)
+	GetOrderingOptionHistoryByID(ctx context.Context, id uuid.UUID) (*OrderingOptionHistory, error)
+	GetOrderingOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOptionHistory, error)
+	UpdateOrderingOptionHistory(ctx context.Context, tx *gorm.DB, optionOrderingHistory *OrderingOptionHistory) (*OrderingOptionHistory, error)
+	DeleteOrderingOptionHistory(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+
+	// Slider related repository methods
+	CreateSliderOption(ctx context.Context, tx *gorm.DB, optionSlider *SliderOption) (*SliderOption, error)
+	GetSliderOptionByID(ctx context.Context, id uuid.UUID) (*SliderOption, error)
+	GetSliderOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOption, error)
+	GetDeleteSliderOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOption, error)
+	UpdateSliderOption(ctx context.Context, tx *gorm.DB, optionSlider *SliderOption) (*SliderOption, error)
+	DeleteSliderOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+	RestoreSliderOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*SliderOption, error)
+	CreateSliderOptionHistory(ctx context.Context, tx *gorm.DB, optionSliderHistory *SliderOptionHistory) (*SliderOptionHistory, error)
+	GetSliderOptionHistoryByID(ctx context.Context, id uuid.UUID) (*SliderOptionHistory, error)
+	GetSliderOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOptionHistory, error)
+	UpdateSliderOptionHistory(ctx context.Context, tx *gorm.DB, optionSliderHistory *SliderOptionHistory) (*SliderOptionHistory, error)
+	DeleteSliderOptionHistory(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+
+	// Hotspot related repository methods
+	CreateHotspotOption(ctx context.Context, tx *gorm.DB, optionHotspot *HotspotOption) (*HotspotOption, error)
+	GetHotspotOptionByID(ctx context.Context, id uuid.UUID) (*HotspotOption, error)
+	GetHotspotOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOption, error)
+	GetDeleteHotspotOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOption, error)
+	UpdateHotspotOption(ctx context.Context, tx *gorm.DB, optionHotspot *HotspotOption) (*HotspotOption, error)
+	DeleteHotspotOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+	RestoreHotspotOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*HotspotOption, error)
+	CreateHotspotOptionHistory(ctx context.Context, tx *gorm.DB, optionHotspotHistory *HotspotOptionHistory) (*HotspotOptionHistory, error)
+	GetHotspotOptionHistoryByID(ctx context.Context, id uuid.UUID) (*HotspotOptionHistory, error)
+	GetHotspotOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOptionHistory, error)
+	UpdateHotspotOptionHistory(ctx context.Context, tx *gorm.DB, optionHotspotHistory *HotspotOptionHistory) (*HotspotOptionHistory, error)
+	DeleteHotspotOptionHistory(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+
 	// Option Matching related repository methods
 	CreateMatchingOption(ctx context.Context, tx *gorm.DB, optionMatching *MatchingOption) (*MatchingOption, error)
 	GetMatchingOptionByID(ctx context.Context, id uuid.UUID) (*MatchingOption, error)
@@ -560,6 +784,90 @@ type TextOptionHistoryResponse struct {
 	TextOptionHistory
 }
 
+// Numeric related structs
+type NumericOptionResponse struct {
+	NumericOption
+}
+
+type NumericOptionRequest struct {
+	NumericOption
+}
+
+type UpdateNumericOptionResponse struct {
+	NumericOption
+}
+
+type CreateNumericOptionResponse struct {
+	NumericOption
+}
+
+type NumericOptionHistoryResponse struct {
+	NumericOptionHistory
+}
+
+// Ordering related structs
+type OrderingOptionResponse struct {
+	OrderingOption
+}
+
+type OrderingOptionRequest struct {
+	OrderingOption
+}
+
+type UpdateOrderingOptionResponse struct {
+	OrderingOption
+}
+
+type CreateOrderingOptionResponse struct {
+	OrderingOption
+}
+
+type OrderingOptionHistoryResponse struct {
+	OrderingOptionHistory
+}
+
+// Slider related structs
+type SliderOptionResponse struct {
+	SliderOption
+}
+
+type SliderOptionRequest struct {
+	SliderOption
+}
+
+type UpdateSliderOptionResponse struct {
+	SliderOption
+}
+
+type CreateSliderOptionResponse struct {
+	SliderOption
+}
+
+type SliderOptionHistoryResponse struct {
+	SliderOptionHistory
+}
+
+// Hotspot related structs
+type HotspotOptionResponse struct {
+	HotspotOption
+}
+
+type HotspotOptionRequest struct {
+	HotspotOption
+}
+
+type UpdateHotspotOptionResponse struct {
+	HotspotOption
+}
+
+type CreateHotspotOptionResponse struct {
+	HotspotOption
+}
+
+type HotspotOptionHistoryResponse struct {
+	HotspotOptionHistory
+}
+
 // Matching related structs
 
 type MatchingOptionAndAnswerResponse struct {
@@ -709,6 +1017,46 @@ type Service interface {
 	GetTextOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]TextOptionHistoryResponse, error)
 	GetTextOptionHistoryByQuestionIDAndContent(ctx context.Context, questionID uuid.UUID, content string) (*TextOptionHistoryResponse, error)
 
+	// Numeric related service methods
+	CreateNumericOption(ctx context.Context, tx *gorm.DB, req *NumericOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateNumericOptionResponse, error)
+	GetNumericOptionsByQuestionID(ctx context.Context, id uuid.UUID) ([]NumericOptionResponse, error)
+	GetDeleteNumericOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOptionResponse, error)
+	UpdateNumericOption(ctx context.Context, tx *gorm.DB, req *NumericOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateNumericOptionResponse, error)
+	DeleteNumericOption(ctx context.Context, tx *gorm.DB, numericOptionID uuid.UUID) error
+	RestoreNumericOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+
+	GetNumericOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOptionHistoryResponse, error)
+
+	// Ordering related service methods
+	CreateOrderingOption(ctx context.Context, tx *gorm.DB, req *OrderingOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateOrderingOptionResponse, error)
+	GetOrderingOptionsByQuestionID(ctx context.Context, id uuid.UUID) ([]OrderingOptionResponse, error)
+	GetDeleteOrderingOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOptionResponse, error)
+	UpdateOrderingOption(ctx context.Context, tx *gorm.DB, req *OrderingOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateOrderingOptionResponse, error)
+	DeleteOrderingOption(ctx context.Context, tx *gorm.DB, orderingOptionID uuid.UUID) error
+	RestoreOrderingOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+
+	GetOrderingOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOptionHistoryResponse, error)
+
+	// Slider related service methods
+	CreateSliderOption(ctx context.Context, tx *gorm.DB, req *SliderOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateSliderOptionResponse, error)
+	GetSliderOptionsByQuestionID(ctx context.Context, id uuid.UUID) ([]SliderOptionResponse, error)
+	GetDeleteSliderOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOptionResponse, error)
+	UpdateSliderOption(ctx context.Context, tx *gorm.DB, req *SliderOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateSliderOptionResponse, error)
+	DeleteSliderOption(ctx context.Context, tx *gorm.DB, sliderOptionID uuid.UUID) error
+	RestoreSliderOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+
+	GetSliderOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOptionHistoryResponse, error)
+
+	// Hotspot related service methods
+	CreateHotspotOption(ctx context.Context, tx *gorm.DB, req *HotspotOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateHotspotOptionResponse, error)
+	GetHotspotOptionsByQuestionID(ctx context.Context, id uuid.UUID) ([]HotspotOptionResponse, error)
+	GetDeleteHotspotOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOptionResponse, error)
+	UpdateHotspotOption(ctx context.Context, tx *gorm.DB, req *HotspotOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateHotspotOptionResponse, error)
+	DeleteHotspotOption(ctx context.Context, tx *gorm.DB, hotspotOptionID uuid.UUID) error
+	RestoreHotspotOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error
+
+	GetHotspotOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOptionHistoryResponse, error)
+
 	// Matching related service methods
 	// ----- Matching Option ------
 	CreateMatchingOption(ctx context.Context, tx *gorm.DB, req *MatchingOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateMatchingOptionResponse, error)
@@ -741,6 +1089,7 @@ type Service interface {
 	GetLatestQuizVersionByID(ctx context.Context, id uuid.UUID) (*uuid.UUID, error)
 	GetQuestionsByQuizIDForLQS(ctx context.Context, id uuid.UUID) ([]any, error)
 	GetAnswersByQuizIDForLQS(ctx context.Context, id uuid.UUID) ([]any, error)
+	GetAnswersByQuestionHistoryIDForLQS(ctx context.Context, t string, qid uuid.UUID) (any, error)
 }
 
 type LQSQuestion struct {
@@ -765,6 +1114,24 @@ type LQSTextOption struct {
 	CaseSensitive bool      `json:"case_sensitive"`
 	Order         int       `json:"order"`
 }
+type LQSNumericOption struct {
+	ID    uuid.UUID `json:"id"`
+	Unit  string    `json:"unit"`
+	Order int       `json:"order"`
+}
+type LQSOrderingOption struct {
+	ID      uuid.UUID `json:"id"`
+	Content string    `json:"content"`
+	Color   string    `json:"color"`
+	Order   int       `json:"order"`
+}
+type LQSSliderOption struct {
+	ID       uuid.UUID `json:"id"`
+	MinValue float64   `json:"min_value"`
+	MaxValue float64   `json:"max_value"`
+	Step     float64   `json:"step"`
+	Order    int       `json:"order"`
+}
 type LQSMatchingOption struct {
 	Prompts []LQSMatchingOptionPrompt `json:"prompts"`
 	Options []LQSMatchingOptionOption `json:"options"`
@@ -794,7 +1161,48 @@ type LQSChoiceAnswer struct {
 }
 type LQSTextAnswer struct {
 	LQSTextOption
-	Content    string    `json:"content"`
+	Content        string    `json:"content"`
+	Mark           int       `json:"mark"`
+	MatchMode      string    `json:"match_mode"`
+	MatchThreshold float64   `json:"match_threshold"`
+	Type           string    `json:"type"`
+	QuestionID     uuid.UUID `json:"qid"`
+}
+type LQSNumericAnswer struct {
+	LQSNumericOption
+	Value      float64   `json:"value"`
+	Tolerance  float64   `json:"tolerance"`
+	MinValue   float64   `json:"min_value"`
+	MaxValue   float64   `json:"max_value"`
+	Mark       int       `json:"mark"`
+	Type       string    `json:"type"`
+	QuestionID uuid.UUID `json:"qid"`
+}
+type LQSOrderingAnswer struct {
+	LQSOrderingOption
+	Mark       int       `json:"mark"`
+	Type       string    `json:"type"`
+	QuestionID uuid.UUID `json:"qid"`
+}
+type LQSSliderAnswer struct {
+	LQSSliderOption
+	Target     float64   `json:"target"`
+	Tolerance  float64   `json:"tolerance"`
+	Falloff    float64   `json:"falloff"`
+	Mark       int       `json:"mark"`
+	Type       string    `json:"type"`
+	QuestionID uuid.UUID `json:"qid"`
+}
+type LQSHotspotAnswer struct {
+	ID         uuid.UUID `json:"id"`
+	Shape      string    `json:"shape"`
+	X          float64   `json:"x"`
+	Y          float64   `json:"y"`
+	Width      float64   `json:"width"`
+	Height     float64   `json:"height"`
+	Radius     float64   `json:"radius"`
+	Points     string    `json:"points"`
+	Order      int       `json:"order"`
 	Mark       int       `json:"mark"`
 	Type       string    `json:"type"`
 	QuestionID uuid.UUID `json:"qid"`
@@ -849,6 +1257,18 @@ func (q ByTOOrder) Len() int           { return len(q) }
 func (q ByTOOrder) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
 func (q ByTOOrder) Less(i, j int) bool { return q[i].Order < q[j].Order }
 
+type ByNOOrder []LQSNumericOption
+
+func (q ByNOOrder) Len() int           { return len(q) }
+func (q ByNOOrder) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q ByNOOrder) Less(i, j int) bool { return q[i].Order < q[j].Order }
+
+type BySOOrder []LQSSliderOption
+
+func (q BySOOrder) Len() int           { return len(q) }
+func (q BySOOrder) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q BySOOrder) Less(i, j int) bool { return q[i].Order < q[j].Order }
+
 type ByMPOrder []LQSMatchingOptionPrompt
 
 func (q ByMPOrder) Len() int           { return len(q) }
@@ -878,3 +1298,27 @@ type ByTAOrder []LQSTextAnswer
 func (q ByTAOrder) Len() int           { return len(q) }
 func (q ByTAOrder) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
 func (q ByTAOrder) Less(i, j int) bool { return q[i].Order < q[j].Order }
+
+type ByNAOrder []LQSNumericAnswer
+
+func (q ByNAOrder) Len() int           { return len(q) }
+func (q ByNAOrder) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q ByNAOrder) Less(i, j int) bool { return q[i].Order < q[j].Order }
+
+type ByOAOrder []LQSOrderingAnswer
+
+func (q ByOAOrder) Len() int           { return len(q) }
+func (q ByOAOrder) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q ByOAOrder) Less(i, j int) bool { return q[i].Order < q[j].Order }
+
+type BySAOrder []LQSSliderAnswer
+
+func (q BySAOrder) Len() int           { return len(q) }
+func (q BySAOrder) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q BySAOrder) Less(i, j int) bool { return q[i].Order < q[j].Order }
+
+type ByHAOrder []LQSHotspotAnswer
+
+func (q ByHAOrder) Len() int           { return len(q) }
+func (q ByHAOrder) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q ByHAOrder) Less(i, j int) bool { return q[i].Order < q[j].Order }
@@ -798,6 +798,514 @@ func (r *repository) DeleteTextOptionHistory(ctx context.Context, tx *gorm.DB, i
 	return nil
 }
 
+// Numeric related repository methods
+func (r *repository) CreateNumericOption(ctx context.Context, tx *gorm.DB, optionNumeric *NumericOption) (*NumericOption, error) {
+	res := tx.WithContext(ctx).Create(optionNumeric)
+	if res.Error != nil {
+		tx.Rollback()
+		return &NumericOption{}, res.Error
+	}
+
+	return optionNumeric, nil
+}
+
+func (r *repository) GetNumericOptionByID(ctx context.Context, id uuid.UUID) (*NumericOption, error) {
+	var optionNumeric NumericOption
+	res := r.db.WithContext(ctx).Where("id = ?", id).First(&optionNumeric)
+	if res.Error != nil {
+		return &NumericOption{}, res.Error
+	}
+
+	return &optionNumeric, nil
+}
+
+func (r *repository) GetNumericOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOption, error) {
+	var optionNumerics []NumericOption
+	res := r.db.WithContext(ctx).Where("question_id = ?", questionID).Find(&optionNumerics)
+	if res.Error != nil {
+		return []NumericOption{}, res.Error
+	}
+
+	return optionNumerics, nil
+}
+
+func (r *repository) GetDeleteNumericOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOption, error) {
+	var optionNumerics []NumericOption
+	res := r.db.WithContext(ctx).Unscoped().Where("question_id = ?", questionID).Find(&optionNumerics)
+	if res.Error != nil {
+		return []NumericOption{}, res.Error
+	}
+
+	return optionNumerics, nil
+}
+
+func (r *repository) UpdateNumericOption(ctx context.Context, tx *gorm.DB, optionNumeric *NumericOption) (*NumericOption, error) {
+	res := tx.WithContext(ctx).Save(optionNumeric)
+	if res.Error != nil {
+		tx.Rollback()
+		return &NumericOption{}, res.Error
+	}
+
+	return optionNumeric, nil
+}
+
+func (r *repository) DeleteNumericOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	res := tx.WithContext(ctx).Delete(&NumericOption{}, id)
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	return nil
+}
+
+func (r *repository) RestoreNumericOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*NumericOption, error) {
+	var optionNumeric NumericOption
+	res := r.db.WithContext(ctx).Unscoped().First(&optionNumeric, id)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	res = tx.WithContext(ctx).Unscoped().Model(&optionNumeric).Update("deleted_at", nil)
+	if res.Error != nil {
+		tx.Rollback()
+		return nil, res.Error
+	}
+
+	return &optionNumeric, nil
+}
+
+func (r *repository) CreateNumericOptionHistory(ctx context.Context, tx *gorm.DB, optionNumericHistory *NumericOptionHistory) (*NumericOptionHistory, error) {
+	res := tx.WithContext(ctx).Create(optionNumericHistory)
+	if res.Error != nil {
+		tx.Rollback()
+		return &NumericOptionHistory{}, res.Error
+	}
+
+	return optionNumericHistory, nil
+}
+
+func (r *repository) GetNumericOptionHistoryByID(ctx context.Context, id uuid.UUID) (*NumericOptionHistory, error) {
+	var optionNumericHistory NumericOptionHistory
+	res := r.db.WithContext(ctx).Where("id = ?", id).First(&optionNumericHistory)
+	if res.Error != nil {
+		return &NumericOptionHistory{}, res.Error
+	}
+
+	return &optionNumericHistory, nil
+}
+
+func (r *repository) GetNumericOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOptionHistory, error) {
+	var optionNumericHistories []NumericOptionHistory
+	res := r.db.WithContext(ctx).Where("question_id = ?", questionID).Find(&optionNumericHistories)
+	if res.Error != nil {
+		return []NumericOptionHistory{}, res.Error
+	}
+
+	return optionNumericHistories, nil
+}
+
+func (r *repository) UpdateNumericOptionHistory(ctx context.Context, tx *gorm.DB, optionNumericHistory *NumericOptionHistory) (*NumericOptionHistory, error) {
+	res := tx.WithContext(ctx).Save(optionNumericHistory)
+	if res.Error != nil {
+		tx.Rollback()
+		return &NumericOptionHistory{}, res.Error
+	}
+
+	return optionNumericHistory, nil
+}
+
+func (r *repository) DeleteNumericOptionHistory(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	res := tx.WithContext(ctx).Delete(&NumericOptionHistory{}, id)
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	return nil
+}
+
+// Ordering related repository methods
+func (r *repository) CreateOrderingOption(ctx context.Context, tx *gorm.DB, optionOrdering *OrderingOption) (*OrderingOption, error) {
+	res := tx.WithContext(ctx).Create(optionOrdering)
+	if res.Error != nil {
+		tx.Rollback()
+		return &OrderingOption{}, res.Error
+	}
+
+	return optionOrdering, nil
+}
+
+func (r *repository) GetOrderingOptionByID(ctx context.Context, id uuid.UUID) (*OrderingOption, error) {
+	var optionOrdering OrderingOption
+	res := r.db.WithContext(ctx).Where("id = ?", id).First(&optionOrdering)
+	if res.Error != nil {
+		return &OrderingOption{}, res.Error
+	}
+
+	return &optionOrdering, nil
+}
+
+func (r *repository) GetOrderingOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOption, error) {
+	var optionOrderings []OrderingOption
+	res := r.db.WithContext(ctx).Where("question_id = ?", questionID).Find(&optionOrderings)
+	if res.Error != nil {
+		return []OrderingOption{}, res.Error
+	}
+
+	return optionOrderings, nil
+}
+
+func (r *repository) GetDeleteOrderingOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOption, error) {
+	var optionOrderings []OrderingOption
+	res := r.db.WithContext(ctx).Unscoped().Where("question_id = ?", questionID).Find(&optionOrderings)
+	if res.Error != nil {
+		return []OrderingOption{}, res.Error
+	}
+
+	return optionOrderings, nil
+}
+
+func (r *repository) UpdateOrderingOption(ctx context.Context, tx *gorm.DB, optionOrdering *OrderingOption) (*OrderingOption, error) {
+	res := tx.WithContext(ctx).Save(optionOrdering)
+	if res.Error != nil {
+		tx.Rollback()
+		return &OrderingOption{}, res.Error
+	}
+
+	return optionOrdering, nil
+}
+
+func (r *repository) DeleteOrderingOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	res := tx.WithContext(ctx).Delete(&OrderingOption{}, id)
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	return nil
+}
+
+func (r *repository) RestoreOrderingOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*OrderingOption, error) {
+	var optionOrdering OrderingOption
+	res := r.db.WithContext(ctx).Unscoped().First(&optionOrdering, id)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	res = tx.WithContext(ctx).Unscoped().Model(&optionOrdering).Update("deleted_at", nil)
+	if res.Error != nil {
+		tx.Rollback()
+		return nil, res.Error
+	}
+
+	return &optionOrdering, nil
+}
+
+func (r *repository) CreateOrderingOptionHistory(ctx context.Context, tx *gorm.DB, optionOrderingHistory *OrderingOptionHistory) (*OrderingOptionHistory, error) {
+	res := tx.WithContext(ctx).Create(optionOrderingHistory)
+	if res.Error != nil {
+		tx.Rollback()
+		return &OrderingOptionHistory{}, res.Error
+	}
+
+	return optionOrderingHistory, nil
+}
+
+func (r *repository) GetOrderingOptionHistoryByID(ctx context.Context, id uuid.UUID) (*OrderingOptionHistory, error) {
+	var optionOrderingHistory OrderingOptionHistory
+	res := r.db.WithContext(ctx).Where("id = ?", id).First(&optionOrderingHistory)
+	if res.Error != nil {
+		return &OrderingOptionHistory{}, res.Error
+	}
+
+	return &optionOrderingHistory, nil
+}
+
+func (r *repository) GetOrderingOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOptionHistory, error) {
+	var optionOrderingHistories []OrderingOptionHistory
+	res := r.db.WithContext(ctx).Where("question_id = ?", questionID).Find(&optionOrderingHistories)
+	if res.Error != nil {
+		return []OrderingOptionHistory{}, res.Error
+	}
+
+	return optionOrderingHistories, nil
+}
+
+func (r *repository) UpdateOrderingOptionHistory(ctx context.Context, tx *gorm.DB, optionOrderingHistory *OrderingOptionHistory) (*OrderingOptionHistory, error) {
+	res := tx.WithContext(ctx).Save(optionOrderingHistory)
+	if res.Error != nil {
+		tx.Rollback()
+		return &OrderingOptionHistory{}, res.Error
+	}
+
+	return optionOrderingHistory, nil
+}
+
+func (r *repository) DeleteOrderingOptionHistory(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	res := tx.WithContext(ctx).Delete(&OrderingOptionHistory{}, id)
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	return nil
+}
+
+// Slider related repository methods
+func (r *repository) CreateSliderOption(ctx context.Context, tx *gorm.DB, optionSlider *SliderOption) (*SliderOption, error) {
+	res := tx.WithContext(ctx).Create(optionSlider)
+	if res.Error != nil {
+		tx.Rollback()
+		return &SliderOption{}, res.Error
+	}
+
+	return optionSlider, nil
+}
+
+func (r *repository) GetSliderOptionByID(ctx context.Context, id uuid.UUID) (*SliderOption, error) {
+	var optionSlider SliderOption
+	res := r.db.WithContext(ctx).Where("id = ?", id).First(&optionSlider)
+	if res.Error != nil {
+		return &SliderOption{}, res.Error
+	}
+
+	return &optionSlider, nil
+}
+
+func (r *repository) GetSliderOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOption, error) {
+	var optionSliders []SliderOption
+	res := r.db.WithContext(ctx).Where("question_id = ?", questionID).Find(&optionSliders)
+	if res.Error != nil {
+		return []SliderOption{}, res.Error
+	}
+
+	return optionSliders, nil
+}
+
+func (r *repository) GetDeleteSliderOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOption, error) {
+	var optionSliders []SliderOption
+	res := r.db.WithContext(ctx).Unscoped().Where("question_id = ?", questionID).Find(&optionSliders)
+	if res.Error != nil {
+		return []SliderOption{}, res.Error
+	}
+
+	return optionSliders, nil
+}
+
+func (r *repository) UpdateSliderOption(ctx context.Context, tx *gorm.DB, optionSlider *SliderOption) (*SliderOption, error) {
+	res := tx.WithContext(ctx).Save(optionSlider)
+	if res.Error != nil {
+		tx.Rollback()
+		return &SliderOption{}, res.Error
+	}
+
+	return optionSlider, nil
+}
+
+func (r *repository) DeleteSliderOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	res := tx.WithContext(ctx).Delete(&SliderOption{}, id)
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	return nil
+}
+
+func (r *repository) RestoreSliderOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*SliderOption, error) {
+	var optionSlider SliderOption
+	res := r.db.WithContext(ctx).Unscoped().First(&optionSlider, id)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	res = tx.WithContext(ctx).Unscoped().Model(&optionSlider).Update("deleted_at", nil)
+	if res.Error != nil {
+		tx.Rollback()
+		return nil, res.Error
+	}
+
+	return &optionSlider, nil
+}
+
+func (r *repository) CreateSliderOptionHistory(ctx context.Context, tx *gorm.DB, optionSliderHistory *SliderOptionHistory) (*SliderOptionHistory, error) {
+	res := tx.WithContext(ctx).Create(optionSliderHistory)
+	if res.Error != nil {
+		tx.Rollback()
+		return &SliderOptionHistory{}, res.Error
+	}
+
+	return optionSliderHistory, nil
+}
+
+func (r *repository) GetSliderOptionHistoryByID(ctx context.Context, id uuid.UUID) (*SliderOptionHistory, error) {
+	var optionSliderHistory SliderOptionHistory
+	res := r.db.WithContext(ctx).Where("id = ?", id).First(&optionSliderHistory)
+	if res.Error != nil {
+		return &SliderOptionHistory{}, res.Error
+	}
+
+	return &optionSliderHistory, nil
+}
+
+func (r *repository) GetSliderOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOptionHistory, error) {
+	var optionSliderHistories []SliderOptionHistory
+	res := r.db.WithContext(ctx).Where("question_id = ?", questionID).Find(&optionSliderHistories)
+	if res.Error != nil {
+		return []SliderOptionHistory{}, res.Error
+	}
+
+	return optionSliderHistories, nil
+}
+
+func (r *repository) UpdateSliderOptionHistory(ctx context.Context, tx *gorm.DB, optionSliderHistory *SliderOptionHistory) (*SliderOptionHistory, error) {
+	res := tx.WithContext(ctx).Save(optionSliderHistory)
+	if res.Error != nil {
+		tx.Rollback()
+		return &SliderOptionHistory{}, res.Error
+	}
+
+	return optionSliderHistory, nil
+}
+
+func (r *repository) DeleteSliderOptionHistory(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	res := tx.WithContext(ctx).Delete(&SliderOptionHistory{}, id)
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	return nil
+}
+
+// Hotspot related repository methods
+func (r *repository) CreateHotspotOption(ctx context.Context, tx *gorm.DB, optionHotspot *HotspotOption) (*HotspotOption, error) {
+	res := tx.WithContext(ctx).Create(optionHotspot)
+	if res.Error != nil {
+		tx.Rollback()
+		return &HotspotOption{}, res.Error
+	}
+
+	return optionHotspot, nil
+}
+
+func (r *repository) GetHotspotOptionByID(ctx context.Context, id uuid.UUID) (*HotspotOption, error) {
+	var optionHotspot HotspotOption
+	res := r.db.WithContext(ctx).Where("id = ?", id).First(&optionHotspot)
+	if res.Error != nil {
+		return &HotspotOption{}, res.Error
+	}
+
+	return &optionHotspot, nil
+}
+
+func (r *repository) GetHotspotOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOption, error) {
+	var optionHotspots []HotspotOption
+	res := r.db.WithContext(ctx).Where("question_id = ?", questionID).Find(&optionHotspots)
+	if res.Error != nil {
+		return []HotspotOption{}, res.Error
+	}
+
+	return optionHotspots, nil
+}
+
+func (r *repository) GetDeleteHotspotOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOption, error) {
+	var optionHotspots []HotspotOption
+	res := r.db.WithContext(ctx).Unscoped().Where("question_id = ?", questionID).Find(&optionHotspots)
+	if res.Error != nil {
+		return []HotspotOption{}, res.Error
+	}
+
+	return optionHotspots, nil
+}
+
+func (r *repository) UpdateHotspotOption(ctx context.Context, tx *gorm.DB, optionHotspot *HotspotOption) (*HotspotOption, error) {
+	res := tx.WithContext(ctx).Save(optionHotspot)
+	if res.Error != nil {
+		tx.Rollback()
+		return &HotspotOption{}, res.Error
+	}
+
+	return optionHotspot, nil
+}
+
+func (r *repository) DeleteHotspotOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	res := tx.WithContext(ctx).Delete(&HotspotOption{}, id)
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	return nil
+}
+
+func (r *repository) RestoreHotspotOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) (*HotspotOption, error) {
+	var optionHotspot HotspotOption
+	res := r.db.WithContext(ctx).Unscoped().First(&optionHotspot, id)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	res = tx.WithContext(ctx).Unscoped().Model(&optionHotspot).Update("deleted_at", nil)
+	if res.Error != nil {
+		tx.Rollback()
+		return nil, res.Error
+	}
+
+	return &optionHotspot, nil
+}
+
+func (r *repository) CreateHotspotOptionHistory(ctx context.Context, tx *gorm.DB, optionHotspotHistory *HotspotOptionHistory) (*HotspotOptionHistory, error) {
+	res := tx.WithContext(ctx).Create(optionHotspotHistory)
+	if res.Error != nil {
+		tx.Rollback()
+		return &HotspotOptionHistory{}, res.Error
+	}
+
+	return optionHotspotHistory, nil
+}
+
+func (r *repository) GetHotspotOptionHistoryByID(ctx context.Context, id uuid.UUID) (*HotspotOptionHistory, error) {
+	var optionHotspotHistory HotspotOptionHistory
+	res := r.db.WithContext(ctx).Where("id = ?", id).First(&optionHotspotHistory)
+	if res.Error != nil {
+		return &HotspotOptionHistory{}, res.Error
+	}
+
+	return &optionHotspotHistory, nil
+}
+
+func (r *repository) GetHotspotOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOptionHistory, error) {
+	var optionHotspotHistories []HotspotOptionHistory
+	res := r.db.WithContext(ctx).Where("question_id = ?", questionID).Find(&optionHotspotHistories)
+	if res.Error != nil {
+		return []HotspotOptionHistory{}, res.Error
+	}
+
+	return optionHotspotHistories, nil
+}
+
+func (r *repository) UpdateHotspotOptionHistory(ctx context.Context, tx *gorm.DB, optionHotspotHistory *HotspotOptionHistory) (*HotspotOptionHistory, error) {
+	res := tx.WithContext(ctx).Save(optionHotspotHistory)
+	if res.Error != nil {
+		tx.Rollback()
+		return &HotspotOptionHistory{}, res.Error
+	}
+
+	return optionHotspotHistory, nil
+}
+
+func (r *repository) DeleteHotspotOptionHistory(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	res := tx.WithContext(ctx).Delete(&HotspotOptionHistory{}, id)
+	if res.Error != nil {
+		tx.Rollback()
+		return res.Error
+	}
+
+	return nil
+}
+
 // Matching related repository methods
 // Option Matching
 func (r *repository) CreateMatchingOption(ctx context.Context, tx *gorm.DB, optionMatching *MatchingOption) (*MatchingOption, error) {
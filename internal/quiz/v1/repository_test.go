@@ -62,7 +62,7 @@ func TestCreateQuiz(t *testing.T) {
 	expectedSQL := "INSERT INTO \"quiz\" (.+) VALUES (.+)"
 	mock.ExpectBegin()
 	mock.ExpectExec(expectedSQL).
-		WithArgs(quiz.ID.String(), quiz.CreatorID.String(), quiz.Title, quiz.Description, quiz.CoverImage, quiz.Visibility, quiz.TimeLimit, quiz.HaveTimeFactor, quiz.TimeFactor, quiz.FontSize, quiz.Mark, quiz.SelectMin, quiz.SelectMax, quiz.CaseSensitive, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(quiz.ID.String(), quiz.CreatorID.String(), quiz.Title, quiz.Description, quiz.CoverImage, quiz.Visibility, quiz.TimeLimit, quiz.HaveTimeFactor, quiz.TimeFactor, quiz.FontSize, quiz.Mark, quiz.SelectMin, quiz.SelectMax, quiz.SelectGrading, quiz.CaseSensitive, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -365,7 +365,7 @@ func TestCreateQuizHistory(t *testing.T) {
 	// Expected Query
 	mock.ExpectBegin()
 	mock.ExpectExec("INSERT INTO \"quiz_history\" (.+) VALUES (.+)").
-		WithArgs(quiz.ID.String(), quiz.QuizID.String(), quiz.CreatorID.String(), quiz.Title, quiz.Description, quiz.CoverImage, quiz.Visibility, quiz.TimeLimit, quiz.HaveTimeFactor, quiz.TimeFactor, quiz.FontSize, quiz.Mark, quiz.SelectMin, quiz.SelectMax, quiz.CaseSensitive, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(quiz.ID.String(), quiz.QuizID.String(), quiz.CreatorID.String(), quiz.Title, quiz.Description, quiz.CoverImage, quiz.Visibility, quiz.TimeLimit, quiz.HaveTimeFactor, quiz.TimeFactor, quiz.FontSize, quiz.Mark, quiz.SelectMin, quiz.SelectMax, quiz.SelectGrading, quiz.CaseSensitive, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -1114,7 +1114,7 @@ func TestCreateQuestion(t *testing.T) {
 	// Expected Query
 	mock.ExpectBegin()
 	mock.ExpectExec("INSERT INTO \"question\" (.+) VALUES (.+)").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -1558,7 +1558,7 @@ func TestCreateQuestionHistory(t *testing.T) {
 	expectedSQL := "INSERT INTO \"question_history\" (.+) VALUES (.+)"
 	mock.ExpectBegin()
 	mock.ExpectExec(expectedSQL).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -2810,7 +2810,7 @@ func TestCreateTextOption(t *testing.T) {
 	expectedSQL := "INSERT INTO \"option_text\" (.+) VALUES (.+)"
 	mock.ExpectBegin()
 	mock.ExpectExec(expectedSQL).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -3152,7 +3152,7 @@ func TestCreateTextOptionHistory(t *testing.T) {
 	expectedSQL := "INSERT INTO \"option_text_history\" (.+) VALUES (.+)"
 	mock.ExpectBegin()
 	mock.ExpectExec(expectedSQL).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -3431,6 +3431,2454 @@ func TestDeleteTextOptionHistory(t *testing.T) {
 	assert.Nil(t, mock.ExpectationsWereMet())
 }
 
+func TestCreateNumericOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Value:      9.8,
+		Tolerance:  0.1,
+		Unit:       "m/s^2",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	expectedSQL := "INSERT INTO \"option_numeric\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_numeric\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_numeric\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateNumericOption(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+func TestGetNumericOptionByID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Value:      9.8,
+		Tolerance:  0.1,
+		Unit:       "m/s^2",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_numeric\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_numeric\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetNumericOptionByID(context.TODO(), data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+func TestGetNumericOptionsByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Value:      9.8,
+		Tolerance:  0.1,
+		Unit:       "m/s^2",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_numeric\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_numeric\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetNumericOptionsByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDeleteNumericOptionsByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Value:      9.8,
+		Tolerance:  0.1,
+		Unit:       "m/s^2",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_numeric\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_numeric\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetDeleteNumericOptionsByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateNumericOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Value:      9.8,
+		Tolerance:  0.1,
+		Unit:       "m/s^2",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_numeric\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_numeric\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.UpdateNumericOption(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteNumericOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Value:      9.8,
+		Tolerance:  0.1,
+		Unit:       "m/s^2",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_numeric\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_numeric\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.DeleteNumericOption(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	// assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestRestoreNumericOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Value:      9.8,
+		Tolerance:  0.1,
+		Unit:       "m/s^2",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_numeric\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_numeric\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.RestoreNumericOption(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateNumericOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOptionHistory{
+		ID:              uuid.New(),
+		OptionNumericID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Value:           9.8,
+		Tolerance:       0.1,
+		Unit:            "m/s^2",
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	expectedSQL := "INSERT INTO \"option_numeric_history\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_numeric_id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionNumericID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_numeric_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_numeric_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateNumericOptionHistory(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetNumericOptionHistoryByID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOptionHistory{
+		ID:              uuid.New(),
+		OptionNumericID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Value:           9.8,
+		Tolerance:       0.1,
+		Unit:            "m/s^2",
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "option_numeric_id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+		AddRow(data.ID.String(), data.OptionNumericID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_numeric_history\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_numeric_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetNumericOptionHistoryByID(context.TODO(), data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetNumericOptionHistoriesByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOptionHistory{
+		ID:              uuid.New(),
+		OptionNumericID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Value:           9.8,
+		Tolerance:       0.1,
+		Unit:            "m/s^2",
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "option_numeric_id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+		AddRow(data.ID.String(), data.OptionNumericID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_numeric_history\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_numeric_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetNumericOptionHistoriesByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateNumericOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOptionHistory{
+		ID:              uuid.New(),
+		OptionNumericID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Value:           9.8,
+		Tolerance:       0.1,
+		Unit:            "m/s^2",
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_numeric_id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionNumericID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_numeric_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_numeric_history\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.UpdateNumericOptionHistory(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteNumericOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &NumericOptionHistory{
+		ID:              uuid.New(),
+		OptionNumericID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Value:           9.8,
+		Tolerance:       0.1,
+		Unit:            "m/s^2",
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_numeric_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_numeric_id", "question_id", "order", "value", "tolerance", "unit", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionNumericID.String(), data.QuestionID.String(), data.Order, data.Value, data.Tolerance, data.Unit, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_numeric_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_numeric_history\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.DeleteNumericOptionHistory(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	//assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateOrderingOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Content:    "Moon landing",
+		Color:      "#FFFFFF",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	expectedSQL := "INSERT INTO \"option_order\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "content", "color", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_order\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_order\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateOrderingOption(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+func TestGetOrderingOptionByID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Content:    "Moon landing",
+		Color:      "#FFFFFF",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "content", "color", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_order\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_order\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetOrderingOptionByID(context.TODO(), data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+func TestGetOrderingOptionsByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Content:    "Moon landing",
+		Color:      "#FFFFFF",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "content", "color", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_order\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_order\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetOrderingOptionsByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDeleteOrderingOptionsByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Content:    "Moon landing",
+		Color:      "#FFFFFF",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "content", "color", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_order\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_order\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetDeleteOrderingOptionsByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateOrderingOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Content:    "Moon landing",
+		Color:      "#FFFFFF",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "content", "color", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_order\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_order\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.UpdateOrderingOption(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteOrderingOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Content:    "Moon landing",
+		Color:      "#FFFFFF",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "content", "color", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_order\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_order\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.DeleteOrderingOption(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	// assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestRestoreOrderingOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Content:    "Moon landing",
+		Color:      "#FFFFFF",
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "content", "color", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_order\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_order\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.RestoreOrderingOption(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateOrderingOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOptionHistory{
+		ID:            uuid.New(),
+		OptionOrderID: uuid.New(),
+		QuestionID:    uuid.New(),
+		Order:         1,
+		Content:       "Moon landing",
+		Color:         "#FFFFFF",
+		Mark:          10,
+	}
+
+	// ===== CREATE  =====
+	expectedSQL := "INSERT INTO \"option_order_history\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_order_id", "question_id", "order", "content", "color", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionOrderID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_order_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_order_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateOrderingOptionHistory(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOrderingOptionHistoryByID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOptionHistory{
+		ID:            uuid.New(),
+		OptionOrderID: uuid.New(),
+		QuestionID:    uuid.New(),
+		Order:         1,
+		Content:       "Moon landing",
+		Color:         "#FFFFFF",
+		Mark:          10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "option_order_id", "question_id", "order", "content", "color", "mark"}).
+		AddRow(data.ID.String(), data.OptionOrderID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_order_history\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_order_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetOrderingOptionHistoryByID(context.TODO(), data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOrderingOptionHistoriesByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOptionHistory{
+		ID:            uuid.New(),
+		OptionOrderID: uuid.New(),
+		QuestionID:    uuid.New(),
+		Order:         1,
+		Content:       "Moon landing",
+		Color:         "#FFFFFF",
+		Mark:          10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "option_order_id", "question_id", "order", "content", "color", "mark"}).
+		AddRow(data.ID.String(), data.OptionOrderID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_order_history\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_order_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetOrderingOptionHistoriesByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateOrderingOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOptionHistory{
+		ID:            uuid.New(),
+		OptionOrderID: uuid.New(),
+		QuestionID:    uuid.New(),
+		Order:         1,
+		Content:       "Moon landing",
+		Color:         "#FFFFFF",
+		Mark:          10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_order_id", "question_id", "order", "content", "color", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionOrderID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_order_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_order_history\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.UpdateOrderingOptionHistory(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteOrderingOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &OrderingOptionHistory{
+		ID:            uuid.New(),
+		OptionOrderID: uuid.New(),
+		QuestionID:    uuid.New(),
+		Order:         1,
+		Content:       "Moon landing",
+		Color:         "#FFFFFF",
+		Mark:          10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_order_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_order_id", "question_id", "order", "content", "color", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionOrderID.String(), data.QuestionID.String(), data.Order, data.Content, data.Color, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_order_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_order_history\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.DeleteOrderingOptionHistory(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	//assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateSliderOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		MinValue:   0,
+		MaxValue:   100,
+		Step:       1,
+		Target:     42,
+		Tolerance:  5,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	expectedSQL := "INSERT INTO \"option_slider\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_slider\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_slider\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateSliderOption(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+func TestGetSliderOptionByID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		MinValue:   0,
+		MaxValue:   100,
+		Step:       1,
+		Target:     42,
+		Tolerance:  5,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_slider\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_slider\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetSliderOptionByID(context.TODO(), data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+func TestGetSliderOptionsByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		MinValue:   0,
+		MaxValue:   100,
+		Step:       1,
+		Target:     42,
+		Tolerance:  5,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_slider\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_slider\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetSliderOptionsByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDeleteSliderOptionsByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		MinValue:   0,
+		MaxValue:   100,
+		Step:       1,
+		Target:     42,
+		Tolerance:  5,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_slider\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_slider\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetDeleteSliderOptionsByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSliderOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		MinValue:   0,
+		MaxValue:   100,
+		Step:       1,
+		Target:     42,
+		Tolerance:  5,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_slider\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_slider\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.UpdateSliderOption(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteSliderOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		MinValue:   0,
+		MaxValue:   100,
+		Step:       1,
+		Target:     42,
+		Tolerance:  5,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_slider\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_slider\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.DeleteSliderOption(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	// assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestRestoreSliderOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		MinValue:   0,
+		MaxValue:   100,
+		Step:       1,
+		Target:     42,
+		Tolerance:  5,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_slider\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_slider\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.RestoreSliderOption(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateSliderOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOptionHistory{
+		ID:             uuid.New(),
+		OptionSliderID: uuid.New(),
+		QuestionID:     uuid.New(),
+		Order:          1,
+		MinValue:       0,
+		MaxValue:       100,
+		Step:           1,
+		Target:         42,
+		Tolerance:      5,
+		Mark:           10,
+	}
+
+	// ===== CREATE  =====
+	expectedSQL := "INSERT INTO \"option_slider_history\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_slider_id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionSliderID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_slider_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_slider_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateSliderOptionHistory(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSliderOptionHistoryByID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOptionHistory{
+		ID:             uuid.New(),
+		OptionSliderID: uuid.New(),
+		QuestionID:     uuid.New(),
+		Order:          1,
+		MinValue:       0,
+		MaxValue:       100,
+		Step:           1,
+		Target:         42,
+		Tolerance:      5,
+		Mark:           10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "option_slider_id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+		AddRow(data.ID.String(), data.OptionSliderID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_slider_history\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_slider_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetSliderOptionHistoryByID(context.TODO(), data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSliderOptionHistoriesByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOptionHistory{
+		ID:             uuid.New(),
+		OptionSliderID: uuid.New(),
+		QuestionID:     uuid.New(),
+		Order:          1,
+		MinValue:       0,
+		MaxValue:       100,
+		Step:           1,
+		Target:         42,
+		Tolerance:      5,
+		Mark:           10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "option_slider_id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+		AddRow(data.ID.String(), data.OptionSliderID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_slider_history\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_slider_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetSliderOptionHistoriesByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSliderOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOptionHistory{
+		ID:             uuid.New(),
+		OptionSliderID: uuid.New(),
+		QuestionID:     uuid.New(),
+		Order:          1,
+		MinValue:       0,
+		MaxValue:       100,
+		Step:           1,
+		Target:         42,
+		Tolerance:      5,
+		Mark:           10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_slider_id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionSliderID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_slider_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_slider_history\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.UpdateSliderOptionHistory(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteSliderOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &SliderOptionHistory{
+		ID:             uuid.New(),
+		OptionSliderID: uuid.New(),
+		QuestionID:     uuid.New(),
+		Order:          1,
+		MinValue:       0,
+		MaxValue:       100,
+		Step:           1,
+		Target:         42,
+		Tolerance:      5,
+		Mark:           10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_slider_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_slider_id", "question_id", "order", "min_value", "max_value", "step", "target", "tolerance", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionSliderID.String(), data.QuestionID.String(), data.Order, data.MinValue, data.MaxValue, data.Step, data.Target, data.Tolerance, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_slider_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_slider_history\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.DeleteSliderOptionHistory(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	//assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateHotspotOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Shape:      "RECTANGLE",
+		X:          0.25,
+		Y:          0.4,
+		Width:      0.1,
+		Height:     0.2,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	expectedSQL := "INSERT INTO \"option_hotspot\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_hotspot\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_hotspot\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateHotspotOption(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+func TestGetHotspotOptionByID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Shape:      "RECTANGLE",
+		X:          0.25,
+		Y:          0.4,
+		Width:      0.1,
+		Height:     0.2,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_hotspot\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_hotspot\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetHotspotOptionByID(context.TODO(), data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+func TestGetHotspotOptionsByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Shape:      "RECTANGLE",
+		X:          0.25,
+		Y:          0.4,
+		Width:      0.1,
+		Height:     0.2,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_hotspot\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_hotspot\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetHotspotOptionsByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDeleteHotspotOptionsByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Shape:      "RECTANGLE",
+		X:          0.25,
+		Y:          0.4,
+		Width:      0.1,
+		Height:     0.2,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_hotspot\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_hotspot\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetDeleteHotspotOptionsByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateHotspotOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Shape:      "RECTANGLE",
+		X:          0.25,
+		Y:          0.4,
+		Width:      0.1,
+		Height:     0.2,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_hotspot\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_hotspot\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.UpdateHotspotOption(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteHotspotOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Shape:      "RECTANGLE",
+		X:          0.25,
+		Y:          0.4,
+		Width:      0.1,
+		Height:     0.2,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+	// 	AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_hotspot\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_hotspot\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.DeleteHotspotOption(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	// assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestRestoreHotspotOption(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOption{
+		ID:         uuid.New(),
+		QuestionID: uuid.New(),
+		Order:      1,
+		Shape:      "RECTANGLE",
+		X:          0.25,
+		Y:          0.4,
+		Width:      0.1,
+		Height:     0.2,
+		Mark:       10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+		AddRow(data.ID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_hotspot\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_hotspot\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.RestoreHotspotOption(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateHotspotOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOptionHistory{
+		ID:              uuid.New(),
+		OptionHotspotID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Shape:           "RECTANGLE",
+		X:               0.25,
+		Y:               0.4,
+		Width:           0.1,
+		Height:          0.2,
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	expectedSQL := "INSERT INTO \"option_hotspot_history\" (.+) VALUES (.+)"
+	mock.ExpectBegin()
+	mock.ExpectExec(expectedSQL).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()). // Number of Data in Struct
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_hotspot_id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionHotspotID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_hotspot_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_hotspot_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.CreateHotspotOptionHistory(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetHotspotOptionHistoryByID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOptionHistory{
+		ID:              uuid.New(),
+		OptionHotspotID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Shape:           "RECTANGLE",
+		X:               0.25,
+		Y:               0.4,
+		Width:           0.1,
+		Height:          0.2,
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "option_hotspot_id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+		AddRow(data.ID.String(), data.OptionHotspotID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_hotspot_history\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.ID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_hotspot_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetHotspotOptionHistoryByID(context.TODO(), data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestGetHotspotOptionHistoriesByQuestionID(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOptionHistory{
+		ID:              uuid.New(),
+		OptionHotspotID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Shape:           "RECTANGLE",
+		X:               0.25,
+		Y:               0.4,
+		Width:           0.1,
+		Height:          0.2,
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	sample := sqlmock.NewRows([]string{"id", "option_hotspot_id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+		AddRow(data.ID.String(), data.OptionHotspotID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// Expected Query
+	expectedSQL := "SELECT (.+) FROM \"option_hotspot_history\" .+"
+	mock.ExpectQuery(expectedSQL).
+		WithArgs(data.QuestionID).
+		WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	// mock.ExpectBegin()
+	// mock.ExpectExec("UPDATE \"option_hotspot_history\" SET .+").
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.GetHotspotOptionHistoriesByQuestionID(context.TODO(), data.QuestionID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateHotspotOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOptionHistory{
+		ID:              uuid.New(),
+		OptionHotspotID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Shape:           "RECTANGLE",
+		X:               0.25,
+		Y:               0.4,
+		Width:           0.1,
+		Height:          0.2,
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_hotspot_id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionHotspotID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_hotspot_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_hotspot_history\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	res, err := repo.UpdateHotspotOptionHistory(context.TODO(), db, data)
+
+	// Unit Test
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteHotspotOptionHistory(t *testing.T) {
+	// Setup Test
+	sqlDB, db, mock := DbMock(t)
+	defer sqlDB.Close()
+	repo := NewRepository(db)
+
+	// Mock Data
+	data := &HotspotOptionHistory{
+		ID:              uuid.New(),
+		OptionHotspotID: uuid.New(),
+		QuestionID:      uuid.New(),
+		Order:           1,
+		Shape:           "RECTANGLE",
+		X:               0.25,
+		Y:               0.4,
+		Width:           0.1,
+		Height:          0.2,
+		Mark:            10,
+	}
+
+	// ===== CREATE  =====
+	// expectedSQL := "INSERT INTO \"option_hotspot_history\" (.+) VALUES (.+)"
+	// mock.ExpectBegin()
+	// mock.ExpectExec(expectedSQL).
+	// 	WithArgs(sqlmock.AnyArg()). // Number of Data in Struct
+	// 	WillReturnResult(sqlmock.NewResult(1, 1))
+	// mock.ExpectCommit()
+
+	// ===== GET RESTORE =====
+	// sample := sqlmock.NewRows([]string{"id", "option_hotspot_id", "question_id", "order", "shape", "x", "y", "width", "height", "mark"}).
+	// 	AddRow(data.ID.String(), data.OptionHotspotID.String(), data.QuestionID.String(), data.Order, data.Shape, data.X, data.Y, data.Width, data.Height, data.Mark)
+
+	// // Expected Query
+	// expectedSQL := "SELECT (.+) FROM \"option_hotspot_history\" .+"
+	// mock.ExpectQuery(expectedSQL).
+	// 	WithArgs(data.QuizID).
+	// 	WillReturnRows(sample)
+
+	// ===== UPDATE DELETE RESTORE =====
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE \"option_hotspot_history\" SET .+").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Actual Function
+	err := repo.DeleteHotspotOptionHistory(context.TODO(), db, data.ID)
+
+	// Unit Test
+	assert.NoError(t, err)
+	//assert.NotNil(t, res)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
 func TestCreateMatchingOption(t *testing.T) {
 	// Setup Test
 	sqlDB, db, mock := DbMock(t)
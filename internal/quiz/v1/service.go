@@ -63,6 +63,7 @@ func (s *service) CreateQuiz(ctx context.Context, tx *gorm.DB, req *CreateQuizRe
 		// SelectUpTo:     req.SelectUpTo,
 		SelectMin:     req.SelectMin,
 		SelectMax:     req.SelectMax,
+		SelectGrading: req.SelectGrading,
 		CaseSensitive: req.CaseSensitive,
 	}
 
@@ -81,6 +82,7 @@ func (s *service) CreateQuiz(ctx context.Context, tx *gorm.DB, req *CreateQuizRe
 		Mark:           q.Mark,
 		SelectMin:      q.SelectMin,
 		SelectMax:      q.SelectMax,
+		SelectGrading:  q.SelectGrading,
 		CaseSensitive:  q.CaseSensitive,
 	}
 
@@ -109,6 +111,7 @@ func (s *service) CreateQuiz(ctx context.Context, tx *gorm.DB, req *CreateQuizRe
 				Mark:           quiz.Mark,
 				SelectMin:      quiz.SelectMin,
 				SelectMax:      quiz.SelectMax,
+				SelectGrading:  quiz.SelectGrading,
 				CaseSensitive:  quiz.CaseSensitive,
 				CreatedAt:      quiz.CreatedAt,
 				UpdatedAt:      quiz.UpdatedAt,
@@ -203,7 +206,7 @@ func (s *service) GetQuizByID(ctx context.Context, id uuid.UUID, uid uuid.UUID)
 	}
 
 	for _, qr := range qRes {
-		if qr.Type == util.Choice || qr.Type == util.TrueFalse {
+		if qr.Type == util.Choice || qr.Type == util.TrueFalse || qr.Type == util.Poll {
 			ocRes, err := s.GetChoiceOptionsByQuestionID(c, qr.ID)
 			if err != nil {
 				return nil, err
@@ -248,6 +251,7 @@ func (s *service) GetQuizByID(ctx context.Context, id uuid.UUID, uid uuid.UUID)
 					LayoutIdx:      qr.LayoutIdx,
 					SelectMin:      qr.SelectMin,
 					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
 					CreatedAt:      qr.CreatedAt,
 					UpdatedAt:      qr.UpdatedAt,
 				},
@@ -263,15 +267,17 @@ func (s *service) GetQuizByID(ctx context.Context, id uuid.UUID, uid uuid.UUID)
 			for _, otr := range otRes {
 				ot = append(ot, TextOptionResponse{
 					TextOption: TextOption{
-						ID:            otr.ID,
-						QuestionID:    otr.QuestionID,
-						Order:         otr.Order,
-						Content:       otr.Content,
-						Mark:          otr.Mark,
-						CaseSensitive: otr.CaseSensitive,
-						CreatedAt:     otr.CreatedAt,
-						UpdatedAt:     otr.UpdatedAt,
-						DeletedAt:     otr.DeletedAt,
+						ID:             otr.ID,
+						QuestionID:     otr.QuestionID,
+						Order:          otr.Order,
+						Content:        otr.Content,
+						Mark:           otr.Mark,
+						CaseSensitive:  otr.CaseSensitive,
+						MatchMode:      otr.MatchMode,
+						MatchThreshold: otr.MatchThreshold,
+						CreatedAt:      otr.CreatedAt,
+						UpdatedAt:      otr.UpdatedAt,
+						DeletedAt:      otr.DeletedAt,
 					},
 				})
 			}
@@ -297,11 +303,224 @@ func (s *service) GetQuizByID(ctx context.Context, id uuid.UUID, uid uuid.UUID)
 					LayoutIdx:      qr.LayoutIdx,
 					SelectMin:      qr.SelectMin,
 					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
 					CreatedAt:      qr.CreatedAt,
 					UpdatedAt:      qr.UpdatedAt,
 				},
 				Options: ot,
 			})
+		} else if qr.Type == util.Numeric {
+			onRes, err := s.GetNumericOptionsByQuestionID(c, qr.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			var on []any
+			for _, onr := range onRes {
+				on = append(on, NumericOptionResponse{
+					NumericOption: NumericOption{
+						ID:         onr.ID,
+						QuestionID: onr.QuestionID,
+						Order:      onr.Order,
+						Value:      onr.Value,
+						Tolerance:  onr.Tolerance,
+						MinValue:   onr.MinValue,
+						MaxValue:   onr.MaxValue,
+						Unit:       onr.Unit,
+						Mark:       onr.Mark,
+						CreatedAt:  onr.CreatedAt,
+						UpdatedAt:  onr.UpdatedAt,
+						DeletedAt:  onr.DeletedAt,
+					},
+				})
+			}
+
+			res.Questions = append(res.Questions, QuestionResponse{
+				Question: Question{
+					ID:             qr.ID,
+					QuizID:         qr.QuizID,
+					QuestionPoolID: qr.QuestionPoolID,
+					Type:           qr.Type,
+					Order:          qr.Order,
+					PoolOrder:      qr.PoolOrder,
+					PoolRequired:   qr.PoolRequired,
+					Content:        qr.Content,
+					Note:           qr.Note,
+					Media:          qr.Media,
+					MediaType:      qr.MediaType,
+					UseTemplate:    qr.UseTemplate,
+					TimeLimit:      qr.TimeLimit,
+					HaveTimeFactor: qr.HaveTimeFactor,
+					TimeFactor:     qr.TimeFactor,
+					FontSize:       qr.FontSize,
+					LayoutIdx:      qr.LayoutIdx,
+					SelectMin:      qr.SelectMin,
+					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
+					CreatedAt:      qr.CreatedAt,
+					UpdatedAt:      qr.UpdatedAt,
+				},
+				Options: on,
+			})
+		} else if qr.Type == util.Ordering {
+			ooRes, err := s.GetOrderingOptionsByQuestionID(c, qr.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			var oo []any
+			for _, oor := range ooRes {
+				oo = append(oo, OrderingOptionResponse{
+					OrderingOption: OrderingOption{
+						ID:         oor.ID,
+						QuestionID: oor.QuestionID,
+						Order:      oor.Order,
+						Content:    oor.Content,
+						Color:      oor.Color,
+						Mark:       oor.Mark,
+						CreatedAt:  oor.CreatedAt,
+						UpdatedAt:  oor.UpdatedAt,
+						DeletedAt:  oor.DeletedAt,
+					},
+				})
+			}
+
+			res.Questions = append(res.Questions, QuestionResponse{
+				Question: Question{
+					ID:             qr.ID,
+					QuizID:         qr.QuizID,
+					QuestionPoolID: qr.QuestionPoolID,
+					Type:           qr.Type,
+					Order:          qr.Order,
+					PoolOrder:      qr.PoolOrder,
+					PoolRequired:   qr.PoolRequired,
+					Content:        qr.Content,
+					Note:           qr.Note,
+					Media:          qr.Media,
+					MediaType:      qr.MediaType,
+					UseTemplate:    qr.UseTemplate,
+					TimeLimit:      qr.TimeLimit,
+					HaveTimeFactor: qr.HaveTimeFactor,
+					TimeFactor:     qr.TimeFactor,
+					FontSize:       qr.FontSize,
+					LayoutIdx:      qr.LayoutIdx,
+					SelectMin:      qr.SelectMin,
+					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
+					CreatedAt:      qr.CreatedAt,
+					UpdatedAt:      qr.UpdatedAt,
+				},
+				Options: oo,
+			})
+		} else if qr.Type == util.Slider {
+			osRes, err := s.GetSliderOptionsByQuestionID(c, qr.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			var os []any
+			for _, osr := range osRes {
+				os = append(os, SliderOptionResponse{
+					SliderOption: SliderOption{
+						ID:         osr.ID,
+						QuestionID: osr.QuestionID,
+						Order:      osr.Order,
+						MinValue:   osr.MinValue,
+						MaxValue:   osr.MaxValue,
+						Step:       osr.Step,
+						Target:     osr.Target,
+						Tolerance:  osr.Tolerance,
+						Falloff:    osr.Falloff,
+						Mark:       osr.Mark,
+						CreatedAt:  osr.CreatedAt,
+						UpdatedAt:  osr.UpdatedAt,
+						DeletedAt:  osr.DeletedAt,
+					},
+				})
+			}
+
+			res.Questions = append(res.Questions, QuestionResponse{
+				Question: Question{
+					ID:             qr.ID,
+					QuizID:         qr.QuizID,
+					QuestionPoolID: qr.QuestionPoolID,
+					Type:           qr.Type,
+					Order:          qr.Order,
+					PoolOrder:      qr.PoolOrder,
+					PoolRequired:   qr.PoolRequired,
+					Content:        qr.Content,
+					Note:           qr.Note,
+					Media:          qr.Media,
+					MediaType:      qr.MediaType,
+					UseTemplate:    qr.UseTemplate,
+					TimeLimit:      qr.TimeLimit,
+					HaveTimeFactor: qr.HaveTimeFactor,
+					TimeFactor:     qr.TimeFactor,
+					FontSize:       qr.FontSize,
+					LayoutIdx:      qr.LayoutIdx,
+					SelectMin:      qr.SelectMin,
+					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
+					CreatedAt:      qr.CreatedAt,
+					UpdatedAt:      qr.UpdatedAt,
+				},
+				Options: os,
+			})
+		} else if qr.Type == util.Hotspot {
+			ohRes, err := s.GetHotspotOptionsByQuestionID(c, qr.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			var oh []any
+			for _, ohr := range ohRes {
+				oh = append(oh, HotspotOptionResponse{
+					HotspotOption: HotspotOption{
+						ID:         ohr.ID,
+						QuestionID: ohr.QuestionID,
+						Order:      ohr.Order,
+						Shape:      ohr.Shape,
+						X:          ohr.X,
+						Y:          ohr.Y,
+						Width:      ohr.Width,
+						Height:     ohr.Height,
+						Radius:     ohr.Radius,
+						Points:     ohr.Points,
+						Mark:       ohr.Mark,
+						CreatedAt:  ohr.CreatedAt,
+						UpdatedAt:  ohr.UpdatedAt,
+						DeletedAt:  ohr.DeletedAt,
+					},
+				})
+			}
+
+			res.Questions = append(res.Questions, QuestionResponse{
+				Question: Question{
+					ID:             qr.ID,
+					QuizID:         qr.QuizID,
+					QuestionPoolID: qr.QuestionPoolID,
+					Type:           qr.Type,
+					Order:          qr.Order,
+					PoolOrder:      qr.PoolOrder,
+					PoolRequired:   qr.PoolRequired,
+					Content:        qr.Content,
+					Note:           qr.Note,
+					Media:          qr.Media,
+					MediaType:      qr.MediaType,
+					UseTemplate:    qr.UseTemplate,
+					TimeLimit:      qr.TimeLimit,
+					HaveTimeFactor: qr.HaveTimeFactor,
+					TimeFactor:     qr.TimeFactor,
+					FontSize:       qr.FontSize,
+					LayoutIdx:      qr.LayoutIdx,
+					SelectMin:      qr.SelectMin,
+					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
+					CreatedAt:      qr.CreatedAt,
+					UpdatedAt:      qr.UpdatedAt,
+				},
+				Options: oh,
+			})
 		} else if qr.Type == util.Matching {
 			omRes, err := s.GetMatchingOptionsByQuestionID(c, qr.ID)
 			if err != nil {
@@ -381,6 +600,7 @@ func (s *service) GetQuizByID(ctx context.Context, id uuid.UUID, uid uuid.UUID)
 					LayoutIdx:      qr.LayoutIdx,
 					SelectMin:      qr.SelectMin,
 					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
 					CreatedAt:      qr.CreatedAt,
 					UpdatedAt:      qr.UpdatedAt,
 				},
@@ -475,7 +695,7 @@ func (s *service) GetQuizHistories(ctx context.Context, uid uuid.UUID) ([]QuizHi
 		}
 
 		for _, qr := range qRes {
-			if qr.Type == util.Choice || qr.Type == util.TrueFalse {
+			if qr.Type == util.Choice || qr.Type == util.TrueFalse || qr.Type == util.Poll {
 				ocRes, err := s.GetChoiceOptionHistoriesByQuestionID(c, qr.ID)
 				if err != nil {
 					return nil, err
@@ -522,6 +742,7 @@ func (s *service) GetQuizHistories(ctx context.Context, uid uuid.UUID) ([]QuizHi
 						LayoutIdx:      qr.LayoutIdx,
 						SelectMin:      qr.SelectMin,
 						SelectMax:      qr.SelectMax,
+						SelectGrading:  qr.SelectGrading,
 						CreatedAt:      qr.CreatedAt,
 						UpdatedAt:      qr.UpdatedAt,
 					},
@@ -538,16 +759,18 @@ func (s *service) GetQuizHistories(ctx context.Context, uid uuid.UUID) ([]QuizHi
 				for _, otr := range otRes {
 					ot = append(ot, TextOptionHistoryResponse{
 						TextOptionHistory: TextOptionHistory{
-							ID:            otr.ID,
-							OptionTextID:  otr.OptionTextID,
-							QuestionID:    otr.QuestionID,
-							Order:         otr.Order,
-							Mark:          otr.Mark,
-							CaseSensitive: otr.CaseSensitive,
-							Content:       otr.Content,
-							CreatedAt:     otr.CreatedAt,
-							UpdatedAt:     otr.UpdatedAt,
-							DeletedAt:     otr.DeletedAt,
+							ID:             otr.ID,
+							OptionTextID:   otr.OptionTextID,
+							QuestionID:     otr.QuestionID,
+							Order:          otr.Order,
+							Mark:           otr.Mark,
+							CaseSensitive:  otr.CaseSensitive,
+							MatchMode:      otr.MatchMode,
+							MatchThreshold: otr.MatchThreshold,
+							Content:        otr.Content,
+							CreatedAt:      otr.CreatedAt,
+							UpdatedAt:      otr.UpdatedAt,
+							DeletedAt:      otr.DeletedAt,
 						},
 					})
 				}
@@ -574,57 +797,144 @@ func (s *service) GetQuizHistories(ctx context.Context, uid uuid.UUID) ([]QuizHi
 						LayoutIdx:      qr.LayoutIdx,
 						SelectMin:      qr.SelectMin,
 						SelectMax:      qr.SelectMax,
+						SelectGrading:  qr.SelectGrading,
 						CreatedAt:      qr.CreatedAt,
 						UpdatedAt:      qr.UpdatedAt,
 					},
 					Options: ot,
 				})
-			} else if qr.Type == util.Matching {
-				omRes, err := s.GetMatchingOptionHistoriesByQuestionID(c, qr.ID)
+			} else if qr.Type == util.Numeric {
+				onRes, err := s.GetNumericOptionHistoriesByQuestionID(c, qr.ID)
 				if err != nil {
 					return nil, err
 				}
 
-				amRes, err := s.GetMatchingAnswerHistoriesByQuestionID(c, qr.ID)
+				var on []any
+				for _, onr := range onRes {
+					on = append(on, NumericOptionHistoryResponse{
+						NumericOptionHistory: NumericOptionHistory{
+							ID:              onr.ID,
+							OptionNumericID: onr.OptionNumericID,
+							QuestionID:      onr.QuestionID,
+							Order:           onr.Order,
+							Value:           onr.Value,
+							Tolerance:       onr.Tolerance,
+							MinValue:        onr.MinValue,
+							MaxValue:        onr.MaxValue,
+							Unit:            onr.Unit,
+							Mark:            onr.Mark,
+							CreatedAt:       onr.CreatedAt,
+							UpdatedAt:       onr.UpdatedAt,
+							DeletedAt:       onr.DeletedAt,
+						},
+					})
+				}
+
+				q.QuestionHistory = append(q.QuestionHistory, QuestionHistoryResponse{
+					QuestionHistory: QuestionHistory{
+						ID:             qr.ID,
+						QuestionID:     qr.QuestionID,
+						QuizID:         qr.QuizID,
+						QuestionPoolID: qr.QuestionPoolID,
+						Type:           qr.Type,
+						Order:          qr.Order,
+						PoolOrder:      qr.PoolOrder,
+						PoolRequired:   qr.PoolRequired,
+						Content:        qr.Content,
+						Note:           qr.Note,
+						Media:          qr.Media,
+						MediaType:      qr.MediaType,
+						UseTemplate:    qr.UseTemplate,
+						TimeLimit:      qr.TimeLimit,
+						HaveTimeFactor: qr.HaveTimeFactor,
+						TimeFactor:     qr.TimeFactor,
+						FontSize:       qr.FontSize,
+						LayoutIdx:      qr.LayoutIdx,
+						SelectMin:      qr.SelectMin,
+						SelectMax:      qr.SelectMax,
+						SelectGrading:  qr.SelectGrading,
+						CreatedAt:      qr.CreatedAt,
+						UpdatedAt:      qr.UpdatedAt,
+					},
+					Options: on,
+				})
+			} else if qr.Type == util.Ordering {
+				ooRes, err := s.GetOrderingOptionHistoriesByQuestionID(c, qr.ID)
 				if err != nil {
 					return nil, err
 				}
 
-				var o []any
-				for _, omr := range omRes {
-					o = append(o, MatchingOptionAndAnswerHistoryResponse{
-						ID:               omr.ID,
-						OptionMatchingID: &omr.OptionMatchingID,
-						QuestionID:       omr.QuestionID,
-						Type:             omr.Type,
-						Order:            &omr.Order,
-						Content:          &omr.Content,
-						Color:            &omr.Color,
-						Eliminate:        omr.Eliminate,
-						PromptID:         nil,
-						OptionID:         nil,
-						Mark:             nil,
-						CreatedAt:        omr.CreatedAt,
-						UpdatedAt:        omr.UpdatedAt,
-						DeletedAt:        omr.DeletedAt,
+				var oo []any
+				for _, oor := range ooRes {
+					oo = append(oo, OrderingOptionHistoryResponse{
+						OrderingOptionHistory: OrderingOptionHistory{
+							ID:            oor.ID,
+							OptionOrderID: oor.OptionOrderID,
+							QuestionID:    oor.QuestionID,
+							Order:         oor.Order,
+							Content:       oor.Content,
+							Color:         oor.Color,
+							Mark:          oor.Mark,
+							CreatedAt:     oor.CreatedAt,
+							UpdatedAt:     oor.UpdatedAt,
+							DeletedAt:     oor.DeletedAt,
+						},
 					})
 				}
 
-				for _, amr := range amRes {
-					o = append(o, MatchingOptionAndAnswerResponse{
-						ID:         amr.ID,
-						QuestionID: amr.QuestionID,
-						Type:       "MATCHING_ANSWER",
-						Order:      nil,
-						Content:    nil,
-						Color:      nil,
-						Eliminate:  false,
-						PromptID:   &amr.PromptID,
-						OptionID:   &amr.OptionID,
-						Mark:       &amr.Mark,
-						CreatedAt:  amr.CreatedAt,
-						UpdatedAt:  amr.UpdatedAt,
-						DeletedAt:  amr.DeletedAt,
+				q.QuestionHistory = append(q.QuestionHistory, QuestionHistoryResponse{
+					QuestionHistory: QuestionHistory{
+						ID:             qr.ID,
+						QuestionID:     qr.QuestionID,
+						QuizID:         qr.QuizID,
+						QuestionPoolID: qr.QuestionPoolID,
+						Type:           qr.Type,
+						Order:          qr.Order,
+						PoolOrder:      qr.PoolOrder,
+						PoolRequired:   qr.PoolRequired,
+						Content:        qr.Content,
+						Note:           qr.Note,
+						Media:          qr.Media,
+						MediaType:      qr.MediaType,
+						UseTemplate:    qr.UseTemplate,
+						TimeLimit:      qr.TimeLimit,
+						HaveTimeFactor: qr.HaveTimeFactor,
+						TimeFactor:     qr.TimeFactor,
+						FontSize:       qr.FontSize,
+						LayoutIdx:      qr.LayoutIdx,
+						SelectMin:      qr.SelectMin,
+						SelectMax:      qr.SelectMax,
+						SelectGrading:  qr.SelectGrading,
+						CreatedAt:      qr.CreatedAt,
+						UpdatedAt:      qr.UpdatedAt,
+					},
+					Options: oo,
+				})
+			} else if qr.Type == util.Slider {
+				osRes, err := s.GetSliderOptionHistoriesByQuestionID(c, qr.ID)
+				if err != nil {
+					return nil, err
+				}
+
+				var os []any
+				for _, osr := range osRes {
+					os = append(os, SliderOptionHistoryResponse{
+						SliderOptionHistory: SliderOptionHistory{
+							ID:             osr.ID,
+							OptionSliderID: osr.OptionSliderID,
+							QuestionID:     osr.QuestionID,
+							Order:          osr.Order,
+							MinValue:       osr.MinValue,
+							MaxValue:       osr.MaxValue,
+							Step:           osr.Step,
+							Target:         osr.Target,
+							Tolerance:      osr.Tolerance,
+							Falloff:        osr.Falloff,
+							Mark:           osr.Mark,
+							CreatedAt:      osr.CreatedAt,
+							UpdatedAt:      osr.UpdatedAt,
+							DeletedAt:      osr.DeletedAt,
+						},
 					})
 				}
 
@@ -650,35 +960,170 @@ func (s *service) GetQuizHistories(ctx context.Context, uid uuid.UUID) ([]QuizHi
 						LayoutIdx:      qr.LayoutIdx,
 						SelectMin:      qr.SelectMin,
 						SelectMax:      qr.SelectMax,
+						SelectGrading:  qr.SelectGrading,
 						CreatedAt:      qr.CreatedAt,
 						UpdatedAt:      qr.UpdatedAt,
 					},
-					Options: o,
+					Options: os,
 				})
-			}
-		}
-
-		res = append(res, q)
-	}
-
-	// Bubble Sort the Questions and Question Pool by Order
-	for _, res := range res {
-		n := len(res.QuestionHistory)
-		for i := 0; i < n-1; i++ {
-			for j := 0; j < n-i-1; j++ {
-				if res.QuestionHistory[j].Order > res.QuestionHistory[j+1].Order {
-					res.QuestionHistory[j], res.QuestionHistory[j+1] = res.QuestionHistory[j+1], res.QuestionHistory[j]
+			} else if qr.Type == util.Hotspot {
+				ohRes, err := s.GetHotspotOptionHistoriesByQuestionID(c, qr.ID)
+				if err != nil {
+					return nil, err
 				}
-			}
-		}
-	}
 
-	return res, nil
-}
+				var oh []any
+				for _, ohr := range ohRes {
+					oh = append(oh, HotspotOptionHistoryResponse{
+						HotspotOptionHistory: HotspotOptionHistory{
+							ID:              ohr.ID,
+							OptionHotspotID: ohr.OptionHotspotID,
+							QuestionID:      ohr.QuestionID,
+							Order:           ohr.Order,
+							Shape:           ohr.Shape,
+							X:               ohr.X,
+							Y:               ohr.Y,
+							Width:           ohr.Width,
+							Height:          ohr.Height,
+							Radius:          ohr.Radius,
+							Points:          ohr.Points,
+							Mark:            ohr.Mark,
+							CreatedAt:       ohr.CreatedAt,
+							UpdatedAt:       ohr.UpdatedAt,
+							DeletedAt:       ohr.DeletedAt,
+						},
+					})
+				}
 
-func (s *service) GetQuizHistoryByID(ctx context.Context, id uuid.UUID, uid uuid.UUID) (*QuizHistoryResponse, error) {
-	c, cancel := context.WithTimeout(ctx, s.timeout)
-	defer cancel()
+				q.QuestionHistory = append(q.QuestionHistory, QuestionHistoryResponse{
+					QuestionHistory: QuestionHistory{
+						ID:             qr.ID,
+						QuestionID:     qr.QuestionID,
+						QuizID:         qr.QuizID,
+						QuestionPoolID: qr.QuestionPoolID,
+						Type:           qr.Type,
+						Order:          qr.Order,
+						PoolOrder:      qr.PoolOrder,
+						PoolRequired:   qr.PoolRequired,
+						Content:        qr.Content,
+						Note:           qr.Note,
+						Media:          qr.Media,
+						MediaType:      qr.MediaType,
+						UseTemplate:    qr.UseTemplate,
+						TimeLimit:      qr.TimeLimit,
+						HaveTimeFactor: qr.HaveTimeFactor,
+						TimeFactor:     qr.TimeFactor,
+						FontSize:       qr.FontSize,
+						LayoutIdx:      qr.LayoutIdx,
+						SelectMin:      qr.SelectMin,
+						SelectMax:      qr.SelectMax,
+						SelectGrading:  qr.SelectGrading,
+						CreatedAt:      qr.CreatedAt,
+						UpdatedAt:      qr.UpdatedAt,
+					},
+					Options: oh,
+				})
+			} else if qr.Type == util.Matching {
+				omRes, err := s.GetMatchingOptionHistoriesByQuestionID(c, qr.ID)
+				if err != nil {
+					return nil, err
+				}
+
+				amRes, err := s.GetMatchingAnswerHistoriesByQuestionID(c, qr.ID)
+				if err != nil {
+					return nil, err
+				}
+
+				var o []any
+				for _, omr := range omRes {
+					o = append(o, MatchingOptionAndAnswerHistoryResponse{
+						ID:               omr.ID,
+						OptionMatchingID: &omr.OptionMatchingID,
+						QuestionID:       omr.QuestionID,
+						Type:             omr.Type,
+						Order:            &omr.Order,
+						Content:          &omr.Content,
+						Color:            &omr.Color,
+						Eliminate:        omr.Eliminate,
+						PromptID:         nil,
+						OptionID:         nil,
+						Mark:             nil,
+						CreatedAt:        omr.CreatedAt,
+						UpdatedAt:        omr.UpdatedAt,
+						DeletedAt:        omr.DeletedAt,
+					})
+				}
+
+				for _, amr := range amRes {
+					o = append(o, MatchingOptionAndAnswerResponse{
+						ID:         amr.ID,
+						QuestionID: amr.QuestionID,
+						Type:       "MATCHING_ANSWER",
+						Order:      nil,
+						Content:    nil,
+						Color:      nil,
+						Eliminate:  false,
+						PromptID:   &amr.PromptID,
+						OptionID:   &amr.OptionID,
+						Mark:       &amr.Mark,
+						CreatedAt:  amr.CreatedAt,
+						UpdatedAt:  amr.UpdatedAt,
+						DeletedAt:  amr.DeletedAt,
+					})
+				}
+
+				q.QuestionHistory = append(q.QuestionHistory, QuestionHistoryResponse{
+					QuestionHistory: QuestionHistory{
+						ID:             qr.ID,
+						QuestionID:     qr.QuestionID,
+						QuizID:         qr.QuizID,
+						QuestionPoolID: qr.QuestionPoolID,
+						Type:           qr.Type,
+						Order:          qr.Order,
+						PoolOrder:      qr.PoolOrder,
+						PoolRequired:   qr.PoolRequired,
+						Content:        qr.Content,
+						Note:           qr.Note,
+						Media:          qr.Media,
+						MediaType:      qr.MediaType,
+						UseTemplate:    qr.UseTemplate,
+						TimeLimit:      qr.TimeLimit,
+						HaveTimeFactor: qr.HaveTimeFactor,
+						TimeFactor:     qr.TimeFactor,
+						FontSize:       qr.FontSize,
+						LayoutIdx:      qr.LayoutIdx,
+						SelectMin:      qr.SelectMin,
+						SelectMax:      qr.SelectMax,
+						SelectGrading:  qr.SelectGrading,
+						CreatedAt:      qr.CreatedAt,
+						UpdatedAt:      qr.UpdatedAt,
+					},
+					Options: o,
+				})
+			}
+		}
+
+		res = append(res, q)
+	}
+
+	// Bubble Sort the Questions and Question Pool by Order
+	for _, res := range res {
+		n := len(res.QuestionHistory)
+		for i := 0; i < n-1; i++ {
+			for j := 0; j < n-i-1; j++ {
+				if res.QuestionHistory[j].Order > res.QuestionHistory[j+1].Order {
+					res.QuestionHistory[j], res.QuestionHistory[j+1] = res.QuestionHistory[j+1], res.QuestionHistory[j]
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (s *service) GetQuizHistoryByID(ctx context.Context, id uuid.UUID, uid uuid.UUID) (*QuizHistoryResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
 
 	quiz, err := s.Repository.GetQuizHistoryByID(c, id)
 	if err != nil {
@@ -732,7 +1177,7 @@ func (s *service) GetQuizHistoryByID(ctx context.Context, id uuid.UUID, uid uuid
 	}
 
 	for _, qr := range qRes {
-		if qr.Type == util.Choice || qr.Type == util.TrueFalse {
+		if qr.Type == util.Choice || qr.Type == util.TrueFalse || qr.Type == util.Poll {
 			ocRes, err := s.GetChoiceOptionHistoriesByQuestionID(c, qr.ID)
 			if err != nil {
 				return nil, err
@@ -779,6 +1224,7 @@ func (s *service) GetQuizHistoryByID(ctx context.Context, id uuid.UUID, uid uuid
 					LayoutIdx:      qr.LayoutIdx,
 					SelectMin:      qr.SelectMin,
 					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
 					CreatedAt:      qr.CreatedAt,
 					UpdatedAt:      qr.UpdatedAt,
 				},
@@ -794,16 +1240,18 @@ func (s *service) GetQuizHistoryByID(ctx context.Context, id uuid.UUID, uid uuid
 			for _, otr := range otRes {
 				ot = append(ot, TextOptionHistoryResponse{
 					TextOptionHistory: TextOptionHistory{
-						ID:            otr.ID,
-						OptionTextID:  otr.OptionTextID,
-						QuestionID:    otr.QuestionID,
-						Order:         otr.Order,
-						Content:       otr.Content,
-						Mark:          otr.Mark,
-						CaseSensitive: otr.CaseSensitive,
-						CreatedAt:     otr.CreatedAt,
-						UpdatedAt:     otr.UpdatedAt,
-						DeletedAt:     otr.DeletedAt,
+						ID:             otr.ID,
+						OptionTextID:   otr.OptionTextID,
+						QuestionID:     otr.QuestionID,
+						Order:          otr.Order,
+						Content:        otr.Content,
+						Mark:           otr.Mark,
+						CaseSensitive:  otr.CaseSensitive,
+						MatchMode:      otr.MatchMode,
+						MatchThreshold: otr.MatchThreshold,
+						CreatedAt:      otr.CreatedAt,
+						UpdatedAt:      otr.UpdatedAt,
+						DeletedAt:      otr.DeletedAt,
 					},
 				})
 			}
@@ -830,58 +1278,144 @@ func (s *service) GetQuizHistoryByID(ctx context.Context, id uuid.UUID, uid uuid
 					LayoutIdx:      qr.LayoutIdx,
 					SelectMin:      qr.SelectMin,
 					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
 					CreatedAt:      qr.CreatedAt,
 					UpdatedAt:      qr.UpdatedAt,
 				},
 				Options: ot,
 			})
-		} else if qr.Type == util.Matching {
-			omRes, err := s.GetMatchingOptionHistoriesByQuestionID(c, qr.ID)
+		} else if qr.Type == util.Numeric {
+			onRes, err := s.GetNumericOptionHistoriesByQuestionID(c, qr.ID)
 			if err != nil {
 				return nil, err
 			}
 
-			amRes, err := s.GetMatchingAnswerHistoriesByQuestionID(c, qr.ID)
+			var on []any
+			for _, onr := range onRes {
+				on = append(on, NumericOptionHistoryResponse{
+					NumericOptionHistory: NumericOptionHistory{
+						ID:              onr.ID,
+						OptionNumericID: onr.OptionNumericID,
+						QuestionID:      onr.QuestionID,
+						Order:           onr.Order,
+						Value:           onr.Value,
+						Tolerance:       onr.Tolerance,
+						MinValue:        onr.MinValue,
+						MaxValue:        onr.MaxValue,
+						Unit:            onr.Unit,
+						Mark:            onr.Mark,
+						CreatedAt:       onr.CreatedAt,
+						UpdatedAt:       onr.UpdatedAt,
+						DeletedAt:       onr.DeletedAt,
+					},
+				})
+			}
+
+			res.QuestionHistory = append(res.QuestionHistory, QuestionHistoryResponse{
+				QuestionHistory: QuestionHistory{
+					ID:             qr.ID,
+					QuestionID:     qr.QuestionID,
+					QuizID:         qr.QuizID,
+					QuestionPoolID: qr.QuestionPoolID,
+					Type:           qr.Type,
+					Order:          qr.Order,
+					PoolOrder:      qr.PoolOrder,
+					PoolRequired:   qr.PoolRequired,
+					Content:        qr.Content,
+					Note:           qr.Note,
+					Media:          qr.Media,
+					MediaType:      qr.MediaType,
+					UseTemplate:    qr.UseTemplate,
+					TimeLimit:      qr.TimeLimit,
+					HaveTimeFactor: qr.HaveTimeFactor,
+					TimeFactor:     qr.TimeFactor,
+					FontSize:       qr.FontSize,
+					LayoutIdx:      qr.LayoutIdx,
+					SelectMin:      qr.SelectMin,
+					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
+					CreatedAt:      qr.CreatedAt,
+					UpdatedAt:      qr.UpdatedAt,
+				},
+				Options: on,
+			})
+		} else if qr.Type == util.Ordering {
+			ooRes, err := s.GetOrderingOptionHistoriesByQuestionID(c, qr.ID)
 			if err != nil {
 				return nil, err
 			}
 
-			var o []any
-			for _, omr := range omRes {
-				o = append(o, MatchingOptionAndAnswerHistoryResponse{
-					ID:               omr.ID,
-					OptionMatchingID: &omr.OptionMatchingID,
-					QuestionID:       omr.QuestionID,
-					Type:             omr.Type,
-					Order:            &omr.Order,
-					Content:          &omr.Content,
-					Color:            &omr.Color,
-					Eliminate:        omr.Eliminate,
-					PromptID:         nil,
-					OptionID:         nil,
-					Mark:             nil,
-					CreatedAt:        omr.CreatedAt,
-					UpdatedAt:        omr.UpdatedAt,
-					DeletedAt:        omr.DeletedAt,
+			var oo []any
+			for _, oor := range ooRes {
+				oo = append(oo, OrderingOptionHistoryResponse{
+					OrderingOptionHistory: OrderingOptionHistory{
+						ID:            oor.ID,
+						OptionOrderID: oor.OptionOrderID,
+						QuestionID:    oor.QuestionID,
+						Order:         oor.Order,
+						Content:       oor.Content,
+						Color:         oor.Color,
+						Mark:          oor.Mark,
+						CreatedAt:     oor.CreatedAt,
+						UpdatedAt:     oor.UpdatedAt,
+						DeletedAt:     oor.DeletedAt,
+					},
 				})
 			}
 
-			for _, amr := range amRes {
-				o = append(o, MatchingOptionAndAnswerHistoryResponse{
-					ID:               amr.ID,
-					AmswerMatchingID: &amr.AnswerMatchingID,
-					QuestionID:       amr.QuestionID,
-					Type:             "MATCHING_ANSWER",
-					Order:            nil,
-					Content:          nil,
-					Color:            nil,
-					Eliminate:        false,
-					PromptID:         &amr.PromptID,
-					OptionID:         &amr.OptionID,
-					Mark:             &amr.Mark,
-					CreatedAt:        amr.CreatedAt,
-					UpdatedAt:        amr.UpdatedAt,
-					DeletedAt:        amr.DeletedAt,
+			res.QuestionHistory = append(res.QuestionHistory, QuestionHistoryResponse{
+				QuestionHistory: QuestionHistory{
+					ID:             qr.ID,
+					QuestionID:     qr.QuestionID,
+					QuizID:         qr.QuizID,
+					QuestionPoolID: qr.QuestionPoolID,
+					Type:           qr.Type,
+					Order:          qr.Order,
+					PoolOrder:      qr.PoolOrder,
+					PoolRequired:   qr.PoolRequired,
+					Content:        qr.Content,
+					Note:           qr.Note,
+					Media:          qr.Media,
+					MediaType:      qr.MediaType,
+					UseTemplate:    qr.UseTemplate,
+					TimeLimit:      qr.TimeLimit,
+					HaveTimeFactor: qr.HaveTimeFactor,
+					TimeFactor:     qr.TimeFactor,
+					FontSize:       qr.FontSize,
+					LayoutIdx:      qr.LayoutIdx,
+					SelectMin:      qr.SelectMin,
+					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
+					CreatedAt:      qr.CreatedAt,
+					UpdatedAt:      qr.UpdatedAt,
+				},
+				Options: oo,
+			})
+		} else if qr.Type == util.Slider {
+			osRes, err := s.GetSliderOptionHistoriesByQuestionID(c, qr.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			var os []any
+			for _, osr := range osRes {
+				os = append(os, SliderOptionHistoryResponse{
+					SliderOptionHistory: SliderOptionHistory{
+						ID:             osr.ID,
+						OptionSliderID: osr.OptionSliderID,
+						QuestionID:     osr.QuestionID,
+						Order:          osr.Order,
+						MinValue:       osr.MinValue,
+						MaxValue:       osr.MaxValue,
+						Step:           osr.Step,
+						Target:         osr.Target,
+						Tolerance:      osr.Tolerance,
+						Falloff:        osr.Falloff,
+						Mark:           osr.Mark,
+						CreatedAt:      osr.CreatedAt,
+						UpdatedAt:      osr.UpdatedAt,
+						DeletedAt:      osr.DeletedAt,
+					},
 				})
 			}
 
@@ -907,40 +1441,176 @@ func (s *service) GetQuizHistoryByID(ctx context.Context, id uuid.UUID, uid uuid
 					LayoutIdx:      qr.LayoutIdx,
 					SelectMin:      qr.SelectMin,
 					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
 					CreatedAt:      qr.CreatedAt,
 					UpdatedAt:      qr.UpdatedAt,
 				},
-				Options: o,
+				Options: os,
 			})
-		}
-	}
-
-	// Bubble Sort the Questions and Question Pool by Order
-	n := len(res.QuestionHistory)
-	for i := 0; i < n-1; i++ {
-		for j := 0; j < n-i-1; j++ {
-			if res.QuestionHistory[j].Order > res.QuestionHistory[j+1].Order {
-				res.QuestionHistory[j], res.QuestionHistory[j+1] = res.QuestionHistory[j+1], res.QuestionHistory[j]
+		} else if qr.Type == util.Hotspot {
+			ohRes, err := s.GetHotspotOptionHistoriesByQuestionID(c, qr.ID)
+			if err != nil {
+				return nil, err
 			}
-		}
-	}
-
-	return res, nil
-}
 
-func (s *service) UpdateQuiz(ctx context.Context, tx *gorm.DB, req *UpdateQuizRequest, uid uuid.UUID, id uuid.UUID) (*UpdateQuizResponse, error) {
-	c, cancel := context.WithTimeout(ctx, s.timeout)
-	defer cancel()
+			var oh []any
+			for _, ohr := range ohRes {
+				oh = append(oh, HotspotOptionHistoryResponse{
+					HotspotOptionHistory: HotspotOptionHistory{
+						ID:              ohr.ID,
+						OptionHotspotID: ohr.OptionHotspotID,
+						QuestionID:      ohr.QuestionID,
+						Order:           ohr.Order,
+						Shape:           ohr.Shape,
+						X:               ohr.X,
+						Y:               ohr.Y,
+						Width:           ohr.Width,
+						Height:          ohr.Height,
+						Radius:          ohr.Radius,
+						Points:          ohr.Points,
+						Mark:            ohr.Mark,
+						CreatedAt:       ohr.CreatedAt,
+						UpdatedAt:       ohr.UpdatedAt,
+						DeletedAt:       ohr.DeletedAt,
+					},
+				})
+			}
 
-	quiz, err := s.Repository.GetQuizByID(c, id)
-	if err != nil {
-		return &UpdateQuizResponse{}, err
-	}
-	if req.Title != "" {
-		quiz.Title = req.Title
-	}
-	if req.Description != "" {
-		quiz.Description = req.Description
+			res.QuestionHistory = append(res.QuestionHistory, QuestionHistoryResponse{
+				QuestionHistory: QuestionHistory{
+					ID:             qr.ID,
+					QuestionID:     qr.QuestionID,
+					QuizID:         qr.QuizID,
+					QuestionPoolID: qr.QuestionPoolID,
+					Type:           qr.Type,
+					Order:          qr.Order,
+					PoolOrder:      qr.PoolOrder,
+					PoolRequired:   qr.PoolRequired,
+					Content:        qr.Content,
+					Note:           qr.Note,
+					Media:          qr.Media,
+					MediaType:      qr.MediaType,
+					UseTemplate:    qr.UseTemplate,
+					TimeLimit:      qr.TimeLimit,
+					HaveTimeFactor: qr.HaveTimeFactor,
+					TimeFactor:     qr.TimeFactor,
+					FontSize:       qr.FontSize,
+					LayoutIdx:      qr.LayoutIdx,
+					SelectMin:      qr.SelectMin,
+					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
+					CreatedAt:      qr.CreatedAt,
+					UpdatedAt:      qr.UpdatedAt,
+				},
+				Options: oh,
+			})
+		} else if qr.Type == util.Matching {
+			omRes, err := s.GetMatchingOptionHistoriesByQuestionID(c, qr.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			amRes, err := s.GetMatchingAnswerHistoriesByQuestionID(c, qr.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			var o []any
+			for _, omr := range omRes {
+				o = append(o, MatchingOptionAndAnswerHistoryResponse{
+					ID:               omr.ID,
+					OptionMatchingID: &omr.OptionMatchingID,
+					QuestionID:       omr.QuestionID,
+					Type:             omr.Type,
+					Order:            &omr.Order,
+					Content:          &omr.Content,
+					Color:            &omr.Color,
+					Eliminate:        omr.Eliminate,
+					PromptID:         nil,
+					OptionID:         nil,
+					Mark:             nil,
+					CreatedAt:        omr.CreatedAt,
+					UpdatedAt:        omr.UpdatedAt,
+					DeletedAt:        omr.DeletedAt,
+				})
+			}
+
+			for _, amr := range amRes {
+				o = append(o, MatchingOptionAndAnswerHistoryResponse{
+					ID:               amr.ID,
+					AmswerMatchingID: &amr.AnswerMatchingID,
+					QuestionID:       amr.QuestionID,
+					Type:             "MATCHING_ANSWER",
+					Order:            nil,
+					Content:          nil,
+					Color:            nil,
+					Eliminate:        false,
+					PromptID:         &amr.PromptID,
+					OptionID:         &amr.OptionID,
+					Mark:             &amr.Mark,
+					CreatedAt:        amr.CreatedAt,
+					UpdatedAt:        amr.UpdatedAt,
+					DeletedAt:        amr.DeletedAt,
+				})
+			}
+
+			res.QuestionHistory = append(res.QuestionHistory, QuestionHistoryResponse{
+				QuestionHistory: QuestionHistory{
+					ID:             qr.ID,
+					QuestionID:     qr.QuestionID,
+					QuizID:         qr.QuizID,
+					QuestionPoolID: qr.QuestionPoolID,
+					Type:           qr.Type,
+					Order:          qr.Order,
+					PoolOrder:      qr.PoolOrder,
+					PoolRequired:   qr.PoolRequired,
+					Content:        qr.Content,
+					Note:           qr.Note,
+					Media:          qr.Media,
+					MediaType:      qr.MediaType,
+					UseTemplate:    qr.UseTemplate,
+					TimeLimit:      qr.TimeLimit,
+					HaveTimeFactor: qr.HaveTimeFactor,
+					TimeFactor:     qr.TimeFactor,
+					FontSize:       qr.FontSize,
+					LayoutIdx:      qr.LayoutIdx,
+					SelectMin:      qr.SelectMin,
+					SelectMax:      qr.SelectMax,
+					SelectGrading:  qr.SelectGrading,
+					CreatedAt:      qr.CreatedAt,
+					UpdatedAt:      qr.UpdatedAt,
+				},
+				Options: o,
+			})
+		}
+	}
+
+	// Bubble Sort the Questions and Question Pool by Order
+	n := len(res.QuestionHistory)
+	for i := 0; i < n-1; i++ {
+		for j := 0; j < n-i-1; j++ {
+			if res.QuestionHistory[j].Order > res.QuestionHistory[j+1].Order {
+				res.QuestionHistory[j], res.QuestionHistory[j+1] = res.QuestionHistory[j+1], res.QuestionHistory[j]
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (s *service) UpdateQuiz(ctx context.Context, tx *gorm.DB, req *UpdateQuizRequest, uid uuid.UUID, id uuid.UUID) (*UpdateQuizResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	quiz, err := s.Repository.GetQuizByID(c, id)
+	if err != nil {
+		return &UpdateQuizResponse{}, err
+	}
+	if req.Title != "" {
+		quiz.Title = req.Title
+	}
+	if req.Description != "" {
+		quiz.Description = req.Description
 	}
 	if req.CoverImage != "" {
 		quiz.CoverImage = req.CoverImage
@@ -978,6 +1648,9 @@ func (s *service) UpdateQuiz(ctx context.Context, tx *gorm.DB, req *UpdateQuizRe
 	// if req.SelectMax != 0 {
 	// 	quiz.SelectMax = req.SelectMax
 	// }
+	if req.SelectGrading != "" {
+		quiz.SelectGrading = req.SelectGrading
+	}
 	if !req.CaseSensitive {
 		quiz.CaseSensitive = req.CaseSensitive
 	}
@@ -997,6 +1670,7 @@ func (s *service) UpdateQuiz(ctx context.Context, tx *gorm.DB, req *UpdateQuizRe
 		Mark:           quiz.Mark,
 		SelectMin:      quiz.SelectMin,
 		SelectMax:      quiz.SelectMax,
+		SelectGrading:  quiz.SelectGrading,
 		CaseSensitive:  quiz.CaseSensitive,
 	}
 
@@ -1026,6 +1700,7 @@ func (s *service) UpdateQuiz(ctx context.Context, tx *gorm.DB, req *UpdateQuizRe
 				Mark:           quiz.Mark,
 				SelectMin:      quiz.SelectMin,
 				SelectMax:      quiz.SelectMax,
+				SelectGrading:  quiz.SelectGrading,
 				CaseSensitive:  quiz.CaseSensitive,
 				CreatedAt:      quiz.CreatedAt,
 				UpdatedAt:      quiz.UpdatedAt,
@@ -1371,6 +2046,7 @@ func (s *service) CreateQuestion(ctx context.Context, tx *gorm.DB, req *Question
 		LayoutIdx:      req.LayoutIdx,
 		SelectMin:      req.SelectMin,
 		SelectMax:      req.SelectMax,
+		SelectGrading:  req.SelectGrading,
 	}
 
 	qh := &QuestionHistory{
@@ -1394,6 +2070,7 @@ func (s *service) CreateQuestion(ctx context.Context, tx *gorm.DB, req *Question
 		LayoutIdx:      q.LayoutIdx,
 		SelectMin:      q.SelectMin,
 		SelectMax:      q.SelectMax,
+		SelectGrading:  q.SelectGrading,
 	}
 
 	question, err := s.Repository.CreateQuestion(c, tx, q)
@@ -1431,6 +2108,7 @@ func (s *service) CreateQuestion(ctx context.Context, tx *gorm.DB, req *Question
 				LayoutIdx:      question.LayoutIdx,
 				SelectMin:      question.SelectMin,
 				SelectMax:      question.SelectMax,
+				SelectGrading:  question.SelectGrading,
 				CreatedAt:      question.CreatedAt,
 				UpdatedAt:      question.UpdatedAt,
 				DeletedAt:      question.DeletedAt,
@@ -1473,6 +2151,7 @@ func (s *service) GetQuestionsByQuizID(ctx context.Context, quizID uuid.UUID) ([
 				LayoutIdx:      q.LayoutIdx,
 				SelectMin:      q.SelectMin,
 				SelectMax:      q.SelectMax,
+				SelectGrading:  q.SelectGrading,
 				CreatedAt:      q.CreatedAt,
 				UpdatedAt:      q.UpdatedAt,
 				DeletedAt:      q.DeletedAt,
@@ -1514,6 +2193,7 @@ func (s *service) GetDeleteQuestionsByQuizID(ctx context.Context, quizID uuid.UU
 				LayoutIdx:      q.LayoutIdx,
 				SelectMin:      q.SelectMin,
 				SelectMax:      q.SelectMax,
+				SelectGrading:  q.SelectGrading,
 				CreatedAt:      q.CreatedAt,
 				UpdatedAt:      q.UpdatedAt,
 				DeletedAt:      q.DeletedAt,
@@ -1595,6 +2275,9 @@ func (s *service) UpdateQuestion(ctx context.Context, tx *gorm.DB, req *Question
 	if req.SelectMax != 0 {
 		question.SelectMax = req.SelectMax
 	}
+	if req.SelectGrading != "" {
+		question.SelectGrading = req.SelectGrading
+	}
 	if req.MediaType != "" {
 		question.MediaType = req.MediaType
 	}
@@ -1629,6 +2312,7 @@ func (s *service) UpdateQuestion(ctx context.Context, tx *gorm.DB, req *Question
 		LayoutIdx:      question.LayoutIdx,
 		SelectMin:      question.SelectMin,
 		SelectMax:      question.SelectMax,
+		SelectGrading:  question.SelectGrading,
 	}
 
 	question, er := s.Repository.UpdateQuestion(c, tx, question)
@@ -1665,6 +2349,7 @@ func (s *service) UpdateQuestion(ctx context.Context, tx *gorm.DB, req *Question
 				LayoutIdx:      question.LayoutIdx,
 				SelectMin:      question.SelectMin,
 				SelectMax:      question.SelectMax,
+				SelectGrading:  question.SelectGrading,
 				CreatedAt:      question.CreatedAt,
 				UpdatedAt:      question.UpdatedAt,
 				DeletedAt:      question.DeletedAt,
@@ -1732,6 +2417,7 @@ func (s *service) GetQuestionHistoriesByQuizID(ctx context.Context, quizID uuid.
 				LayoutIdx:      q.LayoutIdx,
 				SelectMin:      q.SelectMin,
 				SelectMax:      q.SelectMax,
+				SelectGrading:  q.SelectGrading,
 				CreatedAt:      q.CreatedAt,
 				UpdatedAt:      q.UpdatedAt,
 				DeletedAt:      q.DeletedAt,
@@ -1772,6 +2458,7 @@ func (s *service) GetQuestionHistoryByID(ctx context.Context, id uuid.UUID) (*Qu
 			LayoutIdx:      q.LayoutIdx,
 			SelectMin:      q.SelectMin,
 			SelectMax:      q.SelectMax,
+			SelectGrading:  q.SelectGrading,
 			CreatedAt:      q.CreatedAt,
 			UpdatedAt:      q.UpdatedAt,
 			DeletedAt:      q.DeletedAt,
@@ -1940,55 +2627,1077 @@ func (s *service) UpdateChoiceOption(ctx context.Context, tx *gorm.DB, req *Choi
 	if req.Mark != 0 {
 		optionChoice.Mark = req.Mark
 	}
-	if req.Color != "" {
-		optionChoice.Color = req.Color
+	if req.Color != "" {
+		optionChoice.Color = req.Color
+	}
+	if !req.Correct {
+		optionChoice.Correct = req.Correct
+	}
+
+	och := &ChoiceOptionHistory{
+		ID:             uuid.New(),
+		ChoiceOptionID: optionChoice.ID,
+		QuestionID:     questionHistoryID,
+		Order:          optionChoice.Order,
+		Content:        optionChoice.Content,
+		Mark:           optionChoice.Mark,
+		Color:          optionChoice.Color,
+		Correct:        optionChoice.Correct,
+	}
+
+	optionChoice, er := s.Repository.UpdateChoiceOption(c, tx, optionChoice)
+	if er != nil {
+		return &UpdateChoiceOptionResponse{}, er
+	}
+
+	_, e := s.Repository.CreateChoiceOptionHistory(c, tx, och)
+	if e != nil {
+		return &UpdateChoiceOptionResponse{}, e
+	}
+
+	return &UpdateChoiceOptionResponse{
+		ChoiceOption: ChoiceOption{
+			ID:         optionChoice.ID,
+			QuestionID: optionChoice.QuestionID,
+			Order:      optionChoice.Order,
+			Content:    optionChoice.Content,
+			Mark:       optionChoice.Mark,
+			Color:      optionChoice.Color,
+			Correct:    optionChoice.Correct,
+			CreatedAt:  optionChoice.CreatedAt,
+			UpdatedAt:  optionChoice.UpdatedAt,
+			DeletedAt:  optionChoice.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) DeleteChoiceOption(ctx context.Context, tx *gorm.DB, choiceOptionID uuid.UUID) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	e := s.Repository.DeleteChoiceOption(c, tx, choiceOptionID)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func (s *service) RestoreChoiceOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, e := s.Repository.RestoreChoiceOption(c, tx, id)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func (s *service) GetChoiceOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]ChoiceOptionHistoryResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionChoices, err := s.Repository.GetChoiceOptionHistoriesByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []ChoiceOptionHistoryResponse
+	for _, oc := range optionChoices {
+		res = append(res, ChoiceOptionHistoryResponse{
+			ChoiceOptionHistory: ChoiceOptionHistory{
+				ID:             oc.ID,
+				ChoiceOptionID: oc.ChoiceOptionID,
+				QuestionID:     oc.QuestionID,
+				Order:          oc.Order,
+				Content:        oc.Content,
+				Mark:           oc.Mark,
+				Color:          oc.Color,
+				Correct:        oc.Correct,
+				CreatedAt:      oc.CreatedAt,
+				UpdatedAt:      oc.UpdatedAt,
+				DeletedAt:      oc.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) GetChoiceOptionHistoryByQuestionIDAndContent(ctx context.Context, questionID uuid.UUID, content string) (*ChoiceOptionHistoryResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	oc, err := s.Repository.GetChoiceOptionHistoryByQuestionIDAndContent(c, questionID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChoiceOptionHistoryResponse{
+		ChoiceOptionHistory: ChoiceOptionHistory{
+			ID:             oc.ID,
+			ChoiceOptionID: oc.ChoiceOptionID,
+			QuestionID:     oc.QuestionID,
+			Order:          oc.Order,
+			Content:        oc.Content,
+			Mark:           oc.Mark,
+			Color:          oc.Color,
+			Correct:        oc.Correct,
+			CreatedAt:      oc.CreatedAt,
+			UpdatedAt:      oc.UpdatedAt,
+			DeletedAt:      oc.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) GetChoiceOptionHistoryByQuestionIDAndChoiceOptionID(ctx context.Context, questionID uuid.UUID, optionID uuid.UUID) (*ChoiceOptionHistoryResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	oc, err := s.Repository.GetChoiceOptionHistoryByQuestionIDAndChoiceOptionID(c, questionID, optionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChoiceOptionHistoryResponse{
+		ChoiceOptionHistory: ChoiceOptionHistory{
+			ID:             oc.ID,
+			ChoiceOptionID: oc.ChoiceOptionID,
+			QuestionID:     oc.QuestionID,
+			Order:          oc.Order,
+			Content:        oc.Content,
+			Mark:           oc.Mark,
+			Color:          oc.Color,
+			Correct:        oc.Correct,
+			CreatedAt:      oc.CreatedAt,
+			UpdatedAt:      oc.UpdatedAt,
+			DeletedAt:      oc.DeletedAt,
+		},
+	}, nil
+}
+
+// Text related service methods
+func (s *service) CreateTextOption(ctx context.Context, tx *gorm.DB, req *TextOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateTextOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	ot := &TextOption{
+		ID:             uuid.New(),
+		QuestionID:     questionID,
+		Order:          req.Order,
+		Content:        req.Content,
+		Mark:           req.Mark,
+		CaseSensitive:  req.CaseSensitive,
+		MatchMode:      req.MatchMode,
+		MatchThreshold: req.MatchThreshold,
+	}
+
+	oth := &TextOptionHistory{
+		ID:             uuid.New(),
+		OptionTextID:   ot.ID,
+		QuestionID:     questionHistoryID,
+		Order:          ot.Order,
+		Content:        ot.Content,
+		Mark:           ot.Mark,
+		CaseSensitive:  ot.CaseSensitive,
+		MatchMode:      ot.MatchMode,
+		MatchThreshold: ot.MatchThreshold,
+	}
+
+	optionText, err := s.Repository.CreateTextOption(c, tx, ot)
+	if err != nil {
+		return &CreateTextOptionResponse{}, err
+	}
+
+	_, er := s.Repository.CreateTextOptionHistory(c, tx, oth)
+	if er != nil {
+		return &CreateTextOptionResponse{}, er
+	}
+
+	return &CreateTextOptionResponse{
+		TextOption: TextOption{
+			ID:             optionText.ID,
+			QuestionID:     optionText.QuestionID,
+			Order:          optionText.Order,
+			Content:        optionText.Content,
+			Mark:           optionText.Mark,
+			CaseSensitive:  optionText.CaseSensitive,
+			MatchMode:      optionText.MatchMode,
+			MatchThreshold: optionText.MatchThreshold,
+			CreatedAt:      optionText.CreatedAt,
+			UpdatedAt:      optionText.UpdatedAt,
+			DeletedAt:      optionText.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) GetTextOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]TextOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionTexts, err := s.Repository.GetTextOptionsByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []TextOptionResponse
+	for _, ot := range optionTexts {
+		res = append(res, TextOptionResponse{
+			TextOption: TextOption{
+				ID:             ot.ID,
+				QuestionID:     ot.QuestionID,
+				Order:          ot.Order,
+				Content:        ot.Content,
+				Mark:           ot.Mark,
+				CaseSensitive:  ot.CaseSensitive,
+				MatchMode:      ot.MatchMode,
+				MatchThreshold: ot.MatchThreshold,
+				CreatedAt:      ot.CreatedAt,
+				UpdatedAt:      ot.UpdatedAt,
+				DeletedAt:      ot.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) GetDeleteTextOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]TextOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionTexts, err := s.Repository.GetDeleteTextOptionsByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []TextOptionResponse
+	for _, ot := range optionTexts {
+		res = append(res, TextOptionResponse{
+			TextOption: TextOption{
+				ID:             ot.ID,
+				QuestionID:     ot.QuestionID,
+				Order:          ot.Order,
+				Content:        ot.Content,
+				Mark:           ot.Mark,
+				CaseSensitive:  ot.CaseSensitive,
+				MatchMode:      ot.MatchMode,
+				MatchThreshold: ot.MatchThreshold,
+				CreatedAt:      ot.CreatedAt,
+				UpdatedAt:      ot.UpdatedAt,
+				DeletedAt:      ot.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) UpdateTextOption(ctx context.Context, tx *gorm.DB, req *TextOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateTextOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionText, err := s.Repository.GetTextOptionByID(c, optionID)
+	if err != nil {
+		return &UpdateTextOptionResponse{}, err
+	}
+
+	if req.Order != 0 {
+		optionText.Order = req.Order
+	}
+	if req.Content != "" {
+		optionText.Content = req.Content
+	}
+	if req.Mark != 0 {
+		optionText.Mark = req.Mark
+	}
+	if !req.CaseSensitive {
+		optionText.CaseSensitive = req.CaseSensitive
+	}
+	if req.MatchMode != "" {
+		optionText.MatchMode = req.MatchMode
+	}
+	if req.MatchThreshold != 0 {
+		optionText.MatchThreshold = req.MatchThreshold
+	}
+
+	oth := &TextOptionHistory{
+		ID:             uuid.New(),
+		OptionTextID:   optionText.ID,
+		QuestionID:     questionHistoryID,
+		Order:          optionText.Order,
+		Content:        optionText.Content,
+		Mark:           optionText.Mark,
+		CaseSensitive:  optionText.CaseSensitive,
+		MatchMode:      optionText.MatchMode,
+		MatchThreshold: optionText.MatchThreshold,
+	}
+
+	optionText, er := s.Repository.UpdateTextOption(c, tx, optionText)
+	if er != nil {
+		return &UpdateTextOptionResponse{}, er
+	}
+
+	_, e := s.Repository.CreateTextOptionHistory(c, tx, oth)
+	if e != nil {
+		return &UpdateTextOptionResponse{}, e
+	}
+
+	return &UpdateTextOptionResponse{
+		TextOption: TextOption{
+			ID:             optionText.ID,
+			QuestionID:     optionText.QuestionID,
+			Order:          optionText.Order,
+			Content:        optionText.Content,
+			Mark:           optionText.Mark,
+			CaseSensitive:  optionText.CaseSensitive,
+			MatchMode:      optionText.MatchMode,
+			MatchThreshold: optionText.MatchThreshold,
+			CreatedAt:      optionText.CreatedAt,
+			UpdatedAt:      optionText.UpdatedAt,
+			DeletedAt:      optionText.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) DeleteTextOption(ctx context.Context, tx *gorm.DB, textOptionID uuid.UUID) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	e := s.Repository.DeleteTextOption(c, tx, textOptionID)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func (s *service) RestoreTextOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, e := s.Repository.RestoreTextOption(c, tx, id)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func (s *service) GetTextOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]TextOptionHistoryResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionTexts, err := s.Repository.GetTextOptionHistoriesByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []TextOptionHistoryResponse
+	for _, ot := range optionTexts {
+		res = append(res, TextOptionHistoryResponse{
+			TextOptionHistory: TextOptionHistory{
+				ID:             ot.ID,
+				OptionTextID:   ot.OptionTextID,
+				QuestionID:     ot.QuestionID,
+				Order:          ot.Order,
+				Content:        ot.Content,
+				Mark:           ot.Mark,
+				CaseSensitive:  ot.CaseSensitive,
+				MatchMode:      ot.MatchMode,
+				MatchThreshold: ot.MatchThreshold,
+				CreatedAt:      ot.CreatedAt,
+				UpdatedAt:      ot.UpdatedAt,
+				DeletedAt:      ot.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) GetTextOptionHistoryByQuestionIDAndContent(ctx context.Context, questionID uuid.UUID, content string) (*TextOptionHistoryResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	ot, err := s.Repository.GetTextOptionHistoryByQuestionIDAndContent(c, questionID, content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TextOptionHistoryResponse{
+		TextOptionHistory: TextOptionHistory{
+			ID:             ot.ID,
+			OptionTextID:   ot.OptionTextID,
+			QuestionID:     ot.QuestionID,
+			Order:          ot.Order,
+			Content:        ot.Content,
+			Mark:           ot.Mark,
+			CaseSensitive:  ot.CaseSensitive,
+			MatchMode:      ot.MatchMode,
+			MatchThreshold: ot.MatchThreshold,
+			CreatedAt:      ot.CreatedAt,
+			UpdatedAt:      ot.UpdatedAt,
+			DeletedAt:      ot.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) CreateNumericOption(ctx context.Context, tx *gorm.DB, req *NumericOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateNumericOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	on := &NumericOption{
+		ID:         uuid.New(),
+		QuestionID: questionID,
+		Order:      req.Order,
+		Value:      req.Value,
+		Tolerance:  req.Tolerance,
+		MinValue:   req.MinValue,
+		MaxValue:   req.MaxValue,
+		Unit:       req.Unit,
+		Mark:       req.Mark,
+	}
+
+	onh := &NumericOptionHistory{
+		ID:              uuid.New(),
+		OptionNumericID: on.ID,
+		QuestionID:      questionHistoryID,
+		Order:           on.Order,
+		Value:           on.Value,
+		Tolerance:       on.Tolerance,
+		MinValue:        on.MinValue,
+		MaxValue:        on.MaxValue,
+		Unit:            on.Unit,
+		Mark:            on.Mark,
+	}
+
+	optionNumeric, err := s.Repository.CreateNumericOption(c, tx, on)
+	if err != nil {
+		return &CreateNumericOptionResponse{}, err
+	}
+
+	_, er := s.Repository.CreateNumericOptionHistory(c, tx, onh)
+	if er != nil {
+		return &CreateNumericOptionResponse{}, er
+	}
+
+	return &CreateNumericOptionResponse{
+		NumericOption: NumericOption{
+			ID:         optionNumeric.ID,
+			QuestionID: optionNumeric.QuestionID,
+			Order:      optionNumeric.Order,
+			Value:      optionNumeric.Value,
+			Tolerance:  optionNumeric.Tolerance,
+			MinValue:   optionNumeric.MinValue,
+			MaxValue:   optionNumeric.MaxValue,
+			Unit:       optionNumeric.Unit,
+			Mark:       optionNumeric.Mark,
+			CreatedAt:  optionNumeric.CreatedAt,
+			UpdatedAt:  optionNumeric.UpdatedAt,
+			DeletedAt:  optionNumeric.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) GetNumericOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionNumerics, err := s.Repository.GetNumericOptionsByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []NumericOptionResponse
+	for _, on := range optionNumerics {
+		res = append(res, NumericOptionResponse{
+			NumericOption: NumericOption{
+				ID:         on.ID,
+				QuestionID: on.QuestionID,
+				Order:      on.Order,
+				Value:      on.Value,
+				Tolerance:  on.Tolerance,
+				MinValue:   on.MinValue,
+				MaxValue:   on.MaxValue,
+				Unit:       on.Unit,
+				Mark:       on.Mark,
+				CreatedAt:  on.CreatedAt,
+				UpdatedAt:  on.UpdatedAt,
+				DeletedAt:  on.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) GetDeleteNumericOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionNumerics, err := s.Repository.GetDeleteNumericOptionsByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []NumericOptionResponse
+	for _, on := range optionNumerics {
+		res = append(res, NumericOptionResponse{
+			NumericOption: NumericOption{
+				ID:         on.ID,
+				QuestionID: on.QuestionID,
+				Order:      on.Order,
+				Value:      on.Value,
+				Tolerance:  on.Tolerance,
+				MinValue:   on.MinValue,
+				MaxValue:   on.MaxValue,
+				Unit:       on.Unit,
+				Mark:       on.Mark,
+				CreatedAt:  on.CreatedAt,
+				UpdatedAt:  on.UpdatedAt,
+				DeletedAt:  on.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) UpdateNumericOption(ctx context.Context, tx *gorm.DB, req *NumericOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateNumericOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionNumeric, err := s.Repository.GetNumericOptionByID(c, optionID)
+	if err != nil {
+		return &UpdateNumericOptionResponse{}, err
+	}
+
+	if req.Order != 0 {
+		optionNumeric.Order = req.Order
+	}
+	if req.Mark != 0 {
+		optionNumeric.Mark = req.Mark
+	}
+	optionNumeric.Value = req.Value
+	optionNumeric.Tolerance = req.Tolerance
+	optionNumeric.MinValue = req.MinValue
+	optionNumeric.MaxValue = req.MaxValue
+	optionNumeric.Unit = req.Unit
+
+	onh := &NumericOptionHistory{
+		ID:              uuid.New(),
+		OptionNumericID: optionNumeric.ID,
+		QuestionID:      questionHistoryID,
+		Order:           optionNumeric.Order,
+		Value:           optionNumeric.Value,
+		Tolerance:       optionNumeric.Tolerance,
+		MinValue:        optionNumeric.MinValue,
+		MaxValue:        optionNumeric.MaxValue,
+		Unit:            optionNumeric.Unit,
+		Mark:            optionNumeric.Mark,
+	}
+
+	optionNumeric, er := s.Repository.UpdateNumericOption(c, tx, optionNumeric)
+	if er != nil {
+		return &UpdateNumericOptionResponse{}, er
+	}
+
+	_, e := s.Repository.CreateNumericOptionHistory(c, tx, onh)
+	if e != nil {
+		return &UpdateNumericOptionResponse{}, e
+	}
+
+	return &UpdateNumericOptionResponse{
+		NumericOption: NumericOption{
+			ID:         optionNumeric.ID,
+			QuestionID: optionNumeric.QuestionID,
+			Order:      optionNumeric.Order,
+			Value:      optionNumeric.Value,
+			Tolerance:  optionNumeric.Tolerance,
+			MinValue:   optionNumeric.MinValue,
+			MaxValue:   optionNumeric.MaxValue,
+			Unit:       optionNumeric.Unit,
+			Mark:       optionNumeric.Mark,
+			CreatedAt:  optionNumeric.CreatedAt,
+			UpdatedAt:  optionNumeric.UpdatedAt,
+			DeletedAt:  optionNumeric.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) DeleteNumericOption(ctx context.Context, tx *gorm.DB, numericOptionID uuid.UUID) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	e := s.Repository.DeleteNumericOption(c, tx, numericOptionID)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func (s *service) RestoreNumericOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, e := s.Repository.RestoreNumericOption(c, tx, id)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func (s *service) GetNumericOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]NumericOptionHistoryResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionNumerics, err := s.Repository.GetNumericOptionHistoriesByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []NumericOptionHistoryResponse
+	for _, on := range optionNumerics {
+		res = append(res, NumericOptionHistoryResponse{
+			NumericOptionHistory: NumericOptionHistory{
+				ID:              on.ID,
+				OptionNumericID: on.OptionNumericID,
+				QuestionID:      on.QuestionID,
+				Order:           on.Order,
+				Value:           on.Value,
+				Tolerance:       on.Tolerance,
+				MinValue:        on.MinValue,
+				MaxValue:        on.MaxValue,
+				Unit:            on.Unit,
+				Mark:            on.Mark,
+				CreatedAt:       on.CreatedAt,
+				UpdatedAt:       on.UpdatedAt,
+				DeletedAt:       on.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) CreateOrderingOption(ctx context.Context, tx *gorm.DB, req *OrderingOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateOrderingOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	oo := &OrderingOption{
+		ID:         uuid.New(),
+		QuestionID: questionID,
+		Order:      req.Order,
+		Content:    req.Content,
+		Color:      req.Color,
+		Mark:       req.Mark,
+	}
+
+	ooh := &OrderingOptionHistory{
+		ID:            uuid.New(),
+		OptionOrderID: oo.ID,
+		QuestionID:    questionHistoryID,
+		Order:         oo.Order,
+		Content:       oo.Content,
+		Color:         oo.Color,
+		Mark:          oo.Mark,
+	}
+
+	optionOrdering, err := s.Repository.CreateOrderingOption(c, tx, oo)
+	if err != nil {
+		return &CreateOrderingOptionResponse{}, err
+	}
+
+	_, er := s.Repository.CreateOrderingOptionHistory(c, tx, ooh)
+	if er != nil {
+		return &CreateOrderingOptionResponse{}, er
+	}
+
+	return &CreateOrderingOptionResponse{
+		OrderingOption: OrderingOption{
+			ID:         optionOrdering.ID,
+			QuestionID: optionOrdering.QuestionID,
+			Order:      optionOrdering.Order,
+			Content:    optionOrdering.Content,
+			Color:      optionOrdering.Color,
+			Mark:       optionOrdering.Mark,
+			CreatedAt:  optionOrdering.CreatedAt,
+			UpdatedAt:  optionOrdering.UpdatedAt,
+			DeletedAt:  optionOrdering.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) GetOrderingOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionOrderings, err := s.Repository.GetOrderingOptionsByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []OrderingOptionResponse
+	for _, oo := range optionOrderings {
+		res = append(res, OrderingOptionResponse{
+			OrderingOption: OrderingOption{
+				ID:         oo.ID,
+				QuestionID: oo.QuestionID,
+				Order:      oo.Order,
+				Content:    oo.Content,
+				Color:      oo.Color,
+				Mark:       oo.Mark,
+				CreatedAt:  oo.CreatedAt,
+				UpdatedAt:  oo.UpdatedAt,
+				DeletedAt:  oo.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) GetDeleteOrderingOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionOrderings, err := s.Repository.GetDeleteOrderingOptionsByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []OrderingOptionResponse
+	for _, oo := range optionOrderings {
+		res = append(res, OrderingOptionResponse{
+			OrderingOption: OrderingOption{
+				ID:         oo.ID,
+				QuestionID: oo.QuestionID,
+				Order:      oo.Order,
+				Content:    oo.Content,
+				Color:      oo.Color,
+				Mark:       oo.Mark,
+				CreatedAt:  oo.CreatedAt,
+				UpdatedAt:  oo.UpdatedAt,
+				DeletedAt:  oo.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) UpdateOrderingOption(ctx context.Context, tx *gorm.DB, req *OrderingOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateOrderingOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionOrdering, err := s.Repository.GetOrderingOptionByID(c, optionID)
+	if err != nil {
+		return &UpdateOrderingOptionResponse{}, err
+	}
+
+	if req.Order != 0 {
+		optionOrdering.Order = req.Order
+	}
+	if req.Content != "" {
+		optionOrdering.Content = req.Content
+	}
+	if req.Color != "" {
+		optionOrdering.Color = req.Color
+	}
+	if req.Mark != 0 {
+		optionOrdering.Mark = req.Mark
+	}
+
+	ooh := &OrderingOptionHistory{
+		ID:            uuid.New(),
+		OptionOrderID: optionOrdering.ID,
+		QuestionID:    questionHistoryID,
+		Order:         optionOrdering.Order,
+		Content:       optionOrdering.Content,
+		Color:         optionOrdering.Color,
+		Mark:          optionOrdering.Mark,
+	}
+
+	optionOrdering, er := s.Repository.UpdateOrderingOption(c, tx, optionOrdering)
+	if er != nil {
+		return &UpdateOrderingOptionResponse{}, er
+	}
+
+	_, e := s.Repository.CreateOrderingOptionHistory(c, tx, ooh)
+	if e != nil {
+		return &UpdateOrderingOptionResponse{}, e
+	}
+
+	return &UpdateOrderingOptionResponse{
+		OrderingOption: OrderingOption{
+			ID:         optionOrdering.ID,
+			QuestionID: optionOrdering.QuestionID,
+			Order:      optionOrdering.Order,
+			Content:    optionOrdering.Content,
+			Color:      optionOrdering.Color,
+			Mark:       optionOrdering.Mark,
+			CreatedAt:  optionOrdering.CreatedAt,
+			UpdatedAt:  optionOrdering.UpdatedAt,
+			DeletedAt:  optionOrdering.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) DeleteOrderingOption(ctx context.Context, tx *gorm.DB, orderingOptionID uuid.UUID) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	e := s.Repository.DeleteOrderingOption(c, tx, orderingOptionID)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func (s *service) RestoreOrderingOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, e := s.Repository.RestoreOrderingOption(c, tx, id)
+	if e != nil {
+		return e
+	}
+
+	return nil
+}
+
+func (s *service) GetOrderingOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]OrderingOptionHistoryResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionOrderings, err := s.Repository.GetOrderingOptionHistoriesByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []OrderingOptionHistoryResponse
+	for _, oo := range optionOrderings {
+		res = append(res, OrderingOptionHistoryResponse{
+			OrderingOptionHistory: OrderingOptionHistory{
+				ID:            oo.ID,
+				OptionOrderID: oo.OptionOrderID,
+				QuestionID:    oo.QuestionID,
+				Order:         oo.Order,
+				Content:       oo.Content,
+				Color:         oo.Color,
+				Mark:          oo.Mark,
+				CreatedAt:     oo.CreatedAt,
+				UpdatedAt:     oo.UpdatedAt,
+				DeletedAt:     oo.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) CreateSliderOption(ctx context.Context, tx *gorm.DB, req *SliderOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateSliderOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	os := &SliderOption{
+		ID:         uuid.New(),
+		QuestionID: questionID,
+		Order:      req.Order,
+		MinValue:   req.MinValue,
+		MaxValue:   req.MaxValue,
+		Step:       req.Step,
+		Target:     req.Target,
+		Tolerance:  req.Tolerance,
+		Falloff:    req.Falloff,
+		Mark:       req.Mark,
+	}
+
+	osh := &SliderOptionHistory{
+		ID:             uuid.New(),
+		OptionSliderID: os.ID,
+		QuestionID:     questionHistoryID,
+		Order:          os.Order,
+		MinValue:       os.MinValue,
+		MaxValue:       os.MaxValue,
+		Step:           os.Step,
+		Target:         os.Target,
+		Tolerance:      os.Tolerance,
+		Falloff:        os.Falloff,
+		Mark:           os.Mark,
+	}
+
+	optionSlider, err := s.Repository.CreateSliderOption(c, tx, os)
+	if err != nil {
+		return &CreateSliderOptionResponse{}, err
+	}
+
+	_, er := s.Repository.CreateSliderOptionHistory(c, tx, osh)
+	if er != nil {
+		return &CreateSliderOptionResponse{}, er
+	}
+
+	return &CreateSliderOptionResponse{
+		SliderOption: SliderOption{
+			ID:         optionSlider.ID,
+			QuestionID: optionSlider.QuestionID,
+			Order:      optionSlider.Order,
+			MinValue:   optionSlider.MinValue,
+			MaxValue:   optionSlider.MaxValue,
+			Step:       optionSlider.Step,
+			Target:     optionSlider.Target,
+			Tolerance:  optionSlider.Tolerance,
+			Falloff:    optionSlider.Falloff,
+			Mark:       optionSlider.Mark,
+			CreatedAt:  optionSlider.CreatedAt,
+			UpdatedAt:  optionSlider.UpdatedAt,
+			DeletedAt:  optionSlider.DeletedAt,
+		},
+	}, nil
+}
+
+func (s *service) GetSliderOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionSliders, err := s.Repository.GetSliderOptionsByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []SliderOptionResponse
+	for _, os := range optionSliders {
+		res = append(res, SliderOptionResponse{
+			SliderOption: SliderOption{
+				ID:         os.ID,
+				QuestionID: os.QuestionID,
+				Order:      os.Order,
+				MinValue:   os.MinValue,
+				MaxValue:   os.MaxValue,
+				Step:       os.Step,
+				Target:     os.Target,
+				Tolerance:  os.Tolerance,
+				Falloff:    os.Falloff,
+				Mark:       os.Mark,
+				CreatedAt:  os.CreatedAt,
+				UpdatedAt:  os.UpdatedAt,
+				DeletedAt:  os.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) GetDeleteSliderOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionSliders, err := s.Repository.GetDeleteSliderOptionsByQuestionID(c, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []SliderOptionResponse
+	for _, os := range optionSliders {
+		res = append(res, SliderOptionResponse{
+			SliderOption: SliderOption{
+				ID:         os.ID,
+				QuestionID: os.QuestionID,
+				Order:      os.Order,
+				MinValue:   os.MinValue,
+				MaxValue:   os.MaxValue,
+				Step:       os.Step,
+				Target:     os.Target,
+				Tolerance:  os.Tolerance,
+				Falloff:    os.Falloff,
+				Mark:       os.Mark,
+				CreatedAt:  os.CreatedAt,
+				UpdatedAt:  os.UpdatedAt,
+				DeletedAt:  os.DeletedAt,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+func (s *service) UpdateSliderOption(ctx context.Context, tx *gorm.DB, req *SliderOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateSliderOptionResponse, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	optionSlider, err := s.Repository.GetSliderOptionByID(c, optionID)
+	if err != nil {
+		return &UpdateSliderOptionResponse{}, err
+	}
+
+	if req.Order != 0 {
+		optionSlider.Order = req.Order
 	}
-	if !req.Correct {
-		optionChoice.Correct = req.Correct
+	if req.Mark != 0 {
+		optionSlider.Mark = req.Mark
 	}
+	optionSlider.MinValue = req.MinValue
+	optionSlider.MaxValue = req.MaxValue
+	optionSlider.Step = req.Step
+	optionSlider.Target = req.Target
+	optionSlider.Tolerance = req.Tolerance
+	optionSlider.Falloff = req.Falloff
 
-	och := &ChoiceOptionHistory{
+	osh := &SliderOptionHistory{
 		ID:             uuid.New(),
-		ChoiceOptionID: optionChoice.ID,
+		OptionSliderID: optionSlider.ID,
 		QuestionID:     questionHistoryID,
-		Order:          optionChoice.Order,
-		Content:        optionChoice.Content,
-		Mark:           optionChoice.Mark,
-		Color:          optionChoice.Color,
-		Correct:        optionChoice.Correct,
+		Order:          optionSlider.Order,
+		MinValue:       optionSlider.MinValue,
+		MaxValue:       optionSlider.MaxValue,
+		Step:           optionSlider.Step,
+		Target:         optionSlider.Target,
+		Tolerance:      optionSlider.Tolerance,
+		Falloff:        optionSlider.Falloff,
+		Mark:           optionSlider.Mark,
 	}
 
-	optionChoice, er := s.Repository.UpdateChoiceOption(c, tx, optionChoice)
+	optionSlider, er := s.Repository.UpdateSliderOption(c, tx, optionSlider)
 	if er != nil {
-		return &UpdateChoiceOptionResponse{}, er
+		return &UpdateSliderOptionResponse{}, er
 	}
 
-	_, e := s.Repository.CreateChoiceOptionHistory(c, tx, och)
+	_, e := s.Repository.CreateSliderOptionHistory(c, tx, osh)
 	if e != nil {
-		return &UpdateChoiceOptionResponse{}, e
-	}
-
-	return &UpdateChoiceOptionResponse{
-		ChoiceOption: ChoiceOption{
-			ID:         optionChoice.ID,
-			QuestionID: optionChoice.QuestionID,
-			Order:      optionChoice.Order,
-			Content:    optionChoice.Content,
-			Mark:       optionChoice.Mark,
-			Color:      optionChoice.Color,
-			Correct:    optionChoice.Correct,
-			CreatedAt:  optionChoice.CreatedAt,
-			UpdatedAt:  optionChoice.UpdatedAt,
-			DeletedAt:  optionChoice.DeletedAt,
+		return &UpdateSliderOptionResponse{}, e
+	}
+
+	return &UpdateSliderOptionResponse{
+		SliderOption: SliderOption{
+			ID:         optionSlider.ID,
+			QuestionID: optionSlider.QuestionID,
+			Order:      optionSlider.Order,
+			MinValue:   optionSlider.MinValue,
+			MaxValue:   optionSlider.MaxValue,
+			Step:       optionSlider.Step,
+			Target:     optionSlider.Target,
+			Tolerance:  optionSlider.Tolerance,
+			Falloff:    optionSlider.Falloff,
+			Mark:       optionSlider.Mark,
+			CreatedAt:  optionSlider.CreatedAt,
+			UpdatedAt:  optionSlider.UpdatedAt,
+			DeletedAt:  optionSlider.DeletedAt,
 		},
 	}, nil
 }
 
-func (s *service) DeleteChoiceOption(ctx context.Context, tx *gorm.DB, choiceOptionID uuid.UUID) error {
+func (s *service) DeleteSliderOption(ctx context.Context, tx *gorm.DB, sliderOptionID uuid.UUID) error {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	e := s.Repository.DeleteChoiceOption(c, tx, choiceOptionID)
+	e := s.Repository.DeleteSliderOption(c, tx, sliderOptionID)
 	if e != nil {
 		return e
 	}
@@ -1996,11 +3705,11 @@ func (s *service) DeleteChoiceOption(ctx context.Context, tx *gorm.DB, choiceOpt
 	return nil
 }
 
-func (s *service) RestoreChoiceOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+func (s *service) RestoreSliderOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	_, e := s.Repository.RestoreChoiceOption(c, tx, id)
+	_, e := s.Repository.RestoreSliderOption(c, tx, id)
 	if e != nil {
 		return e
 	}
@@ -2008,30 +3717,33 @@ func (s *service) RestoreChoiceOption(ctx context.Context, tx *gorm.DB, id uuid.
 	return nil
 }
 
-func (s *service) GetChoiceOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]ChoiceOptionHistoryResponse, error) {
+func (s *service) GetSliderOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]SliderOptionHistoryResponse, error) {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	optionChoices, err := s.Repository.GetChoiceOptionHistoriesByQuestionID(c, questionID)
+	optionSliders, err := s.Repository.GetSliderOptionHistoriesByQuestionID(c, questionID)
 	if err != nil {
 		return nil, err
 	}
 
-	var res []ChoiceOptionHistoryResponse
-	for _, oc := range optionChoices {
-		res = append(res, ChoiceOptionHistoryResponse{
-			ChoiceOptionHistory: ChoiceOptionHistory{
-				ID:             oc.ID,
-				ChoiceOptionID: oc.ChoiceOptionID,
-				QuestionID:     oc.QuestionID,
-				Order:          oc.Order,
-				Content:        oc.Content,
-				Mark:           oc.Mark,
-				Color:          oc.Color,
-				Correct:        oc.Correct,
-				CreatedAt:      oc.CreatedAt,
-				UpdatedAt:      oc.UpdatedAt,
-				DeletedAt:      oc.DeletedAt,
+	var res []SliderOptionHistoryResponse
+	for _, os := range optionSliders {
+		res = append(res, SliderOptionHistoryResponse{
+			SliderOptionHistory: SliderOptionHistory{
+				ID:             os.ID,
+				OptionSliderID: os.OptionSliderID,
+				QuestionID:     os.QuestionID,
+				Order:          os.Order,
+				MinValue:       os.MinValue,
+				MaxValue:       os.MaxValue,
+				Step:           os.Step,
+				Target:         os.Target,
+				Tolerance:      os.Tolerance,
+				Falloff:        os.Falloff,
+				Mark:           os.Mark,
+				CreatedAt:      os.CreatedAt,
+				UpdatedAt:      os.UpdatedAt,
+				DeletedAt:      os.DeletedAt,
 			},
 		})
 	}
@@ -2039,129 +3751,96 @@ func (s *service) GetChoiceOptionHistoriesByQuestionID(ctx context.Context, ques
 	return res, nil
 }
 
-func (s *service) GetChoiceOptionHistoryByQuestionIDAndContent(ctx context.Context, questionID uuid.UUID, content string) (*ChoiceOptionHistoryResponse, error) {
-	c, cancel := context.WithTimeout(ctx, s.timeout)
-	defer cancel()
-
-	oc, err := s.Repository.GetChoiceOptionHistoryByQuestionIDAndContent(c, questionID, content)
-	if err != nil {
-		return nil, err
-	}
-
-	return &ChoiceOptionHistoryResponse{
-		ChoiceOptionHistory: ChoiceOptionHistory{
-			ID:             oc.ID,
-			ChoiceOptionID: oc.ChoiceOptionID,
-			QuestionID:     oc.QuestionID,
-			Order:          oc.Order,
-			Content:        oc.Content,
-			Mark:           oc.Mark,
-			Color:          oc.Color,
-			Correct:        oc.Correct,
-			CreatedAt:      oc.CreatedAt,
-			UpdatedAt:      oc.UpdatedAt,
-			DeletedAt:      oc.DeletedAt,
-		},
-	}, nil
-}
-
-func (s *service) GetChoiceOptionHistoryByQuestionIDAndChoiceOptionID(ctx context.Context, questionID uuid.UUID, optionID uuid.UUID) (*ChoiceOptionHistoryResponse, error) {
-	c, cancel := context.WithTimeout(ctx, s.timeout)
-	defer cancel()
-
-	oc, err := s.Repository.GetChoiceOptionHistoryByQuestionIDAndChoiceOptionID(c, questionID, optionID)
-	if err != nil {
-		return nil, err
-	}
-
-	return &ChoiceOptionHistoryResponse{
-		ChoiceOptionHistory: ChoiceOptionHistory{
-			ID:             oc.ID,
-			ChoiceOptionID: oc.ChoiceOptionID,
-			QuestionID:     oc.QuestionID,
-			Order:          oc.Order,
-			Content:        oc.Content,
-			Mark:           oc.Mark,
-			Color:          oc.Color,
-			Correct:        oc.Correct,
-			CreatedAt:      oc.CreatedAt,
-			UpdatedAt:      oc.UpdatedAt,
-			DeletedAt:      oc.DeletedAt,
-		},
-	}, nil
-}
-
-// Text related service methods
-func (s *service) CreateTextOption(ctx context.Context, tx *gorm.DB, req *TextOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateTextOptionResponse, error) {
+func (s *service) CreateHotspotOption(ctx context.Context, tx *gorm.DB, req *HotspotOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateHotspotOptionResponse, error) {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	ot := &TextOption{
-		ID:            uuid.New(),
-		QuestionID:    questionID,
-		Order:         req.Order,
-		Content:       req.Content,
-		Mark:          req.Mark,
-		CaseSensitive: req.CaseSensitive,
+	oh := &HotspotOption{
+		ID:         uuid.New(),
+		QuestionID: questionID,
+		Order:      req.Order,
+		Shape:      req.Shape,
+		X:          req.X,
+		Y:          req.Y,
+		Width:      req.Width,
+		Height:     req.Height,
+		Radius:     req.Radius,
+		Points:     req.Points,
+		Mark:       req.Mark,
 	}
 
-	oth := &TextOptionHistory{
-		ID:            uuid.New(),
-		OptionTextID:  ot.ID,
-		QuestionID:    questionHistoryID,
-		Order:         ot.Order,
-		Content:       ot.Content,
-		Mark:          ot.Mark,
-		CaseSensitive: ot.CaseSensitive,
+	ohh := &HotspotOptionHistory{
+		ID:              uuid.New(),
+		OptionHotspotID: oh.ID,
+		QuestionID:      questionHistoryID,
+		Order:           oh.Order,
+		Shape:           oh.Shape,
+		X:               oh.X,
+		Y:               oh.Y,
+		Width:           oh.Width,
+		Height:          oh.Height,
+		Radius:          oh.Radius,
+		Points:          oh.Points,
+		Mark:            oh.Mark,
 	}
 
-	optionText, err := s.Repository.CreateTextOption(c, tx, ot)
+	optionHotspot, err := s.Repository.CreateHotspotOption(c, tx, oh)
 	if err != nil {
-		return &CreateTextOptionResponse{}, err
+		return &CreateHotspotOptionResponse{}, err
 	}
 
-	_, er := s.Repository.CreateTextOptionHistory(c, tx, oth)
+	_, er := s.Repository.CreateHotspotOptionHistory(c, tx, ohh)
 	if er != nil {
-		return &CreateTextOptionResponse{}, er
-	}
-
-	return &CreateTextOptionResponse{
-		TextOption: TextOption{
-			ID:            optionText.ID,
-			QuestionID:    optionText.QuestionID,
-			Order:         optionText.Order,
-			Content:       optionText.Content,
-			Mark:          optionText.Mark,
-			CaseSensitive: optionText.CaseSensitive,
-			CreatedAt:     optionText.CreatedAt,
-			UpdatedAt:     optionText.UpdatedAt,
-			DeletedAt:     optionText.DeletedAt,
+		return &CreateHotspotOptionResponse{}, er
+	}
+
+	return &CreateHotspotOptionResponse{
+		HotspotOption: HotspotOption{
+			ID:         optionHotspot.ID,
+			QuestionID: optionHotspot.QuestionID,
+			Order:      optionHotspot.Order,
+			Shape:      optionHotspot.Shape,
+			X:          optionHotspot.X,
+			Y:          optionHotspot.Y,
+			Width:      optionHotspot.Width,
+			Height:     optionHotspot.Height,
+			Radius:     optionHotspot.Radius,
+			Points:     optionHotspot.Points,
+			Mark:       optionHotspot.Mark,
+			CreatedAt:  optionHotspot.CreatedAt,
+			UpdatedAt:  optionHotspot.UpdatedAt,
+			DeletedAt:  optionHotspot.DeletedAt,
 		},
 	}, nil
 }
 
-func (s *service) GetTextOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]TextOptionResponse, error) {
+func (s *service) GetHotspotOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOptionResponse, error) {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	optionTexts, err := s.Repository.GetTextOptionsByQuestionID(c, questionID)
+	optionHotspots, err := s.Repository.GetHotspotOptionsByQuestionID(c, questionID)
 	if err != nil {
 		return nil, err
 	}
 
-	var res []TextOptionResponse
-	for _, ot := range optionTexts {
-		res = append(res, TextOptionResponse{
-			TextOption: TextOption{
-				ID:            ot.ID,
-				QuestionID:    ot.QuestionID,
-				Order:         ot.Order,
-				Content:       ot.Content,
-				Mark:          ot.Mark,
-				CaseSensitive: ot.CaseSensitive,
-				CreatedAt:     ot.CreatedAt,
-				UpdatedAt:     ot.UpdatedAt,
-				DeletedAt:     ot.DeletedAt,
+	var res []HotspotOptionResponse
+	for _, oh := range optionHotspots {
+		res = append(res, HotspotOptionResponse{
+			HotspotOption: HotspotOption{
+				ID:         oh.ID,
+				QuestionID: oh.QuestionID,
+				Order:      oh.Order,
+				Shape:      oh.Shape,
+				X:          oh.X,
+				Y:          oh.Y,
+				Width:      oh.Width,
+				Height:     oh.Height,
+				Radius:     oh.Radius,
+				Points:     oh.Points,
+				Mark:       oh.Mark,
+				CreatedAt:  oh.CreatedAt,
+				UpdatedAt:  oh.UpdatedAt,
+				DeletedAt:  oh.DeletedAt,
 			},
 		})
 	}
@@ -2169,28 +3848,33 @@ func (s *service) GetTextOptionsByQuestionID(ctx context.Context, questionID uui
 	return res, nil
 }
 
-func (s *service) GetDeleteTextOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]TextOptionResponse, error) {
+func (s *service) GetDeleteHotspotOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOptionResponse, error) {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	optionTexts, err := s.Repository.GetDeleteTextOptionsByQuestionID(c, questionID)
+	optionHotspots, err := s.Repository.GetDeleteHotspotOptionsByQuestionID(c, questionID)
 	if err != nil {
 		return nil, err
 	}
 
-	var res []TextOptionResponse
-	for _, ot := range optionTexts {
-		res = append(res, TextOptionResponse{
-			TextOption: TextOption{
-				ID:            ot.ID,
-				QuestionID:    ot.QuestionID,
-				Order:         ot.Order,
-				Content:       ot.Content,
-				Mark:          ot.Mark,
-				CaseSensitive: ot.CaseSensitive,
-				CreatedAt:     ot.CreatedAt,
-				UpdatedAt:     ot.UpdatedAt,
-				DeletedAt:     ot.DeletedAt,
+	var res []HotspotOptionResponse
+	for _, oh := range optionHotspots {
+		res = append(res, HotspotOptionResponse{
+			HotspotOption: HotspotOption{
+				ID:         oh.ID,
+				QuestionID: oh.QuestionID,
+				Order:      oh.Order,
+				Shape:      oh.Shape,
+				X:          oh.X,
+				Y:          oh.Y,
+				Width:      oh.Width,
+				Height:     oh.Height,
+				Radius:     oh.Radius,
+				Points:     oh.Points,
+				Mark:       oh.Mark,
+				CreatedAt:  oh.CreatedAt,
+				UpdatedAt:  oh.UpdatedAt,
+				DeletedAt:  oh.DeletedAt,
 			},
 		})
 	}
@@ -2198,68 +3882,81 @@ func (s *service) GetDeleteTextOptionsByQuestionID(ctx context.Context, question
 	return res, nil
 }
 
-func (s *service) UpdateTextOption(ctx context.Context, tx *gorm.DB, req *TextOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateTextOptionResponse, error) {
+func (s *service) UpdateHotspotOption(ctx context.Context, tx *gorm.DB, req *HotspotOptionRequest, userID uuid.UUID, optionID uuid.UUID, questionHistoryID uuid.UUID) (*UpdateHotspotOptionResponse, error) {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	optionText, err := s.Repository.GetTextOptionByID(c, optionID)
+	optionHotspot, err := s.Repository.GetHotspotOptionByID(c, optionID)
 	if err != nil {
-		return &UpdateTextOptionResponse{}, err
+		return &UpdateHotspotOptionResponse{}, err
 	}
 
 	if req.Order != 0 {
-		optionText.Order = req.Order
-	}
-	if req.Content != "" {
-		optionText.Content = req.Content
+		optionHotspot.Order = req.Order
 	}
 	if req.Mark != 0 {
-		optionText.Mark = req.Mark
-	}
-	if !req.CaseSensitive {
-		optionText.CaseSensitive = req.CaseSensitive
-	}
-
-	oth := &TextOptionHistory{
-		ID:            uuid.New(),
-		OptionTextID:  optionText.ID,
-		QuestionID:    questionHistoryID,
-		Order:         optionText.Order,
-		Content:       optionText.Content,
-		Mark:          optionText.Mark,
-		CaseSensitive: optionText.CaseSensitive,
-	}
-
-	optionText, er := s.Repository.UpdateTextOption(c, tx, optionText)
+		optionHotspot.Mark = req.Mark
+	}
+	if req.Shape != "" {
+		optionHotspot.Shape = req.Shape
+	}
+	optionHotspot.X = req.X
+	optionHotspot.Y = req.Y
+	optionHotspot.Width = req.Width
+	optionHotspot.Height = req.Height
+	optionHotspot.Radius = req.Radius
+	optionHotspot.Points = req.Points
+
+	ohh := &HotspotOptionHistory{
+		ID:              uuid.New(),
+		OptionHotspotID: optionHotspot.ID,
+		QuestionID:      questionHistoryID,
+		Order:           optionHotspot.Order,
+		Shape:           optionHotspot.Shape,
+		X:               optionHotspot.X,
+		Y:               optionHotspot.Y,
+		Width:           optionHotspot.Width,
+		Height:          optionHotspot.Height,
+		Radius:          optionHotspot.Radius,
+		Points:          optionHotspot.Points,
+		Mark:            optionHotspot.Mark,
+	}
+
+	optionHotspot, er := s.Repository.UpdateHotspotOption(c, tx, optionHotspot)
 	if er != nil {
-		return &UpdateTextOptionResponse{}, er
+		return &UpdateHotspotOptionResponse{}, er
 	}
 
-	_, e := s.Repository.CreateTextOptionHistory(c, tx, oth)
+	_, e := s.Repository.CreateHotspotOptionHistory(c, tx, ohh)
 	if e != nil {
-		return &UpdateTextOptionResponse{}, e
-	}
-
-	return &UpdateTextOptionResponse{
-		TextOption: TextOption{
-			ID:            optionText.ID,
-			QuestionID:    optionText.QuestionID,
-			Order:         optionText.Order,
-			Content:       optionText.Content,
-			Mark:          optionText.Mark,
-			CaseSensitive: optionText.CaseSensitive,
-			CreatedAt:     optionText.CreatedAt,
-			UpdatedAt:     optionText.UpdatedAt,
-			DeletedAt:     optionText.DeletedAt,
+		return &UpdateHotspotOptionResponse{}, e
+	}
+
+	return &UpdateHotspotOptionResponse{
+		HotspotOption: HotspotOption{
+			ID:         optionHotspot.ID,
+			QuestionID: optionHotspot.QuestionID,
+			Order:      optionHotspot.Order,
+			Shape:      optionHotspot.Shape,
+			X:          optionHotspot.X,
+			Y:          optionHotspot.Y,
+			Width:      optionHotspot.Width,
+			Height:     optionHotspot.Height,
+			Radius:     optionHotspot.Radius,
+			Points:     optionHotspot.Points,
+			Mark:       optionHotspot.Mark,
+			CreatedAt:  optionHotspot.CreatedAt,
+			UpdatedAt:  optionHotspot.UpdatedAt,
+			DeletedAt:  optionHotspot.DeletedAt,
 		},
 	}, nil
 }
 
-func (s *service) DeleteTextOption(ctx context.Context, tx *gorm.DB, textOptionID uuid.UUID) error {
+func (s *service) DeleteHotspotOption(ctx context.Context, tx *gorm.DB, hotspotOptionID uuid.UUID) error {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	e := s.Repository.DeleteTextOption(c, tx, textOptionID)
+	e := s.Repository.DeleteHotspotOption(c, tx, hotspotOptionID)
 	if e != nil {
 		return e
 	}
@@ -2267,11 +3964,11 @@ func (s *service) DeleteTextOption(ctx context.Context, tx *gorm.DB, textOptionI
 	return nil
 }
 
-func (s *service) RestoreTextOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
+func (s *service) RestoreHotspotOption(ctx context.Context, tx *gorm.DB, id uuid.UUID) error {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	_, e := s.Repository.RestoreTextOption(c, tx, id)
+	_, e := s.Repository.RestoreHotspotOption(c, tx, id)
 	if e != nil {
 		return e
 	}
@@ -2279,29 +3976,34 @@ func (s *service) RestoreTextOption(ctx context.Context, tx *gorm.DB, id uuid.UU
 	return nil
 }
 
-func (s *service) GetTextOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]TextOptionHistoryResponse, error) {
+func (s *service) GetHotspotOptionHistoriesByQuestionID(ctx context.Context, questionID uuid.UUID) ([]HotspotOptionHistoryResponse, error) {
 	c, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
-	optionTexts, err := s.Repository.GetTextOptionHistoriesByQuestionID(c, questionID)
+	optionHotspots, err := s.Repository.GetHotspotOptionHistoriesByQuestionID(c, questionID)
 	if err != nil {
 		return nil, err
 	}
 
-	var res []TextOptionHistoryResponse
-	for _, ot := range optionTexts {
-		res = append(res, TextOptionHistoryResponse{
-			TextOptionHistory: TextOptionHistory{
-				ID:            ot.ID,
-				OptionTextID:  ot.OptionTextID,
-				QuestionID:    ot.QuestionID,
-				Order:         ot.Order,
-				Content:       ot.Content,
-				Mark:          ot.Mark,
-				CaseSensitive: ot.CaseSensitive,
-				CreatedAt:     ot.CreatedAt,
-				UpdatedAt:     ot.UpdatedAt,
-				DeletedAt:     ot.DeletedAt,
+	var res []HotspotOptionHistoryResponse
+	for _, oh := range optionHotspots {
+		res = append(res, HotspotOptionHistoryResponse{
+			HotspotOptionHistory: HotspotOptionHistory{
+				ID:              oh.ID,
+				OptionHotspotID: oh.OptionHotspotID,
+				QuestionID:      oh.QuestionID,
+				Order:           oh.Order,
+				Shape:           oh.Shape,
+				X:               oh.X,
+				Y:               oh.Y,
+				Width:           oh.Width,
+				Height:          oh.Height,
+				Radius:          oh.Radius,
+				Points:          oh.Points,
+				Mark:            oh.Mark,
+				CreatedAt:       oh.CreatedAt,
+				UpdatedAt:       oh.UpdatedAt,
+				DeletedAt:       oh.DeletedAt,
 			},
 		})
 	}
@@ -2309,31 +4011,6 @@ func (s *service) GetTextOptionHistoriesByQuestionID(ctx context.Context, questi
 	return res, nil
 }
 
-func (s *service) GetTextOptionHistoryByQuestionIDAndContent(ctx context.Context, questionID uuid.UUID, content string) (*TextOptionHistoryResponse, error) {
-	c, cancel := context.WithTimeout(ctx, s.timeout)
-	defer cancel()
-
-	ot, err := s.Repository.GetTextOptionHistoryByQuestionIDAndContent(c, questionID, content)
-	if err != nil {
-		return nil, err
-	}
-
-	return &TextOptionHistoryResponse{
-		TextOptionHistory: TextOptionHistory{
-			ID:            ot.ID,
-			OptionTextID:  ot.OptionTextID,
-			QuestionID:    ot.QuestionID,
-			Order:         ot.Order,
-			Content:       ot.Content,
-			Mark:          ot.Mark,
-			CaseSensitive: ot.CaseSensitive,
-			CreatedAt:     ot.CreatedAt,
-			UpdatedAt:     ot.UpdatedAt,
-			DeletedAt:     ot.DeletedAt,
-		},
-	}, nil
-}
-
 // ------ Matching Option ------
 
 func (s *service) CreateMatchingOption(ctx context.Context, tx *gorm.DB, req *MatchingOptionRequest, questionID uuid.UUID, questionHistoryID uuid.UUID, uid uuid.UUID) (*CreateMatchingOptionResponse, error) {
@@ -2966,7 +4643,7 @@ func (s *service) GetLatestQuizVersionByID(ctx context.Context, id uuid.UUID) (*
 
 func (s *service) getOptionsByQuestionIDForLQS(c context.Context, t string, qid uuid.UUID) (any, error) {
 	switch t {
-	case util.Choice, util.TrueFalse:
+	case util.Choice, util.TrueFalse, util.Poll:
 		ocs, err := s.Repository.GetChoiceOptionHistoriesByQuestionID(c, qid)
 		if err != nil {
 			return nil, err
@@ -2997,6 +4674,60 @@ func (s *service) getOptionsByQuestionIDForLQS(c context.Context, t string, qid
 		}
 		sort.Sort(ByTOOrder(options))
 		return options, nil
+	case util.Numeric:
+		ons, err := s.Repository.GetNumericOptionHistoriesByQuestionID(c, qid)
+		if err != nil {
+			return nil, err
+		}
+		options := make([]LQSNumericOption, 0)
+		for _, on := range ons {
+			options = append(options, LQSNumericOption{
+				ID:    on.ID,
+				Unit:  on.Unit,
+				Order: on.Order,
+			})
+		}
+		sort.Sort(ByNOOrder(options))
+		return options, nil
+	case util.Slider:
+		oss, err := s.Repository.GetSliderOptionHistoriesByQuestionID(c, qid)
+		if err != nil {
+			return nil, err
+		}
+		options := make([]LQSSliderOption, 0)
+		for _, os := range oss {
+			options = append(options, LQSSliderOption{
+				ID:       os.ID,
+				MinValue: os.MinValue,
+				MaxValue: os.MaxValue,
+				Step:     os.Step,
+				Order:    os.Order,
+			})
+		}
+		sort.Sort(BySOOrder(options))
+		return options, nil
+	case util.Ordering:
+		oos, err := s.Repository.GetOrderingOptionHistoriesByQuestionID(c, qid)
+		if err != nil {
+			return nil, err
+		}
+		// The order of the items is the answer, so they are handed out
+		// shuffled and renumbered.
+		options := make([]LQSOrderingOption, len(oos))
+		for i, n := range util.ShuffleNumbers(len(oos)) {
+			oo := oos[n-1]
+			options[i] = LQSOrderingOption{
+				ID:      oo.ID,
+				Content: oo.Content,
+				Color:   oo.Color,
+				Order:   i + 1,
+			}
+		}
+		return options, nil
+	case util.WordCloud, util.Hotspot:
+		// Word clouds take free text and hotspots a click on the question
+		// image, so there is nothing to hand out.
+		return make([]any, 0), nil
 	case util.Matching:
 		oms, err := s.Repository.GetMatchingOptionHistoriesByQuestionID(c, qid)
 		if err != nil {
@@ -3032,9 +4763,18 @@ func (s *service) getOptionsByQuestionIDForLQS(c context.Context, t string, qid
 	return nil, nil
 }
 
+// GetAnswersByQuestionHistoryIDForLQS returns the answer key of a question in
+// the shape a live quiz session scores against.
+func (s *service) GetAnswersByQuestionHistoryIDForLQS(ctx context.Context, t string, qid uuid.UUID) (any, error) {
+	c, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return s.getAnswersByQuestionIDForLQS(c, t, qid)
+}
+
 func (s *service) getAnswersByQuestionIDForLQS(c context.Context, t string, qid uuid.UUID) (any, error) {
 	switch t {
-	case util.Choice, util.TrueFalse:
+	case util.Choice, util.TrueFalse, util.Poll:
 		ocs, err := s.Repository.GetChoiceOptionHistoriesByQuestionID(c, qid)
 		if err != nil {
 			return nil, err
@@ -3069,13 +4809,111 @@ func (s *service) getAnswersByQuestionIDForLQS(c context.Context, t string, qid
 					CaseSensitive: ot.CaseSensitive,
 					Order:         ot.Order,
 				},
-				Content:    ot.Content,
-				Mark:       ot.Mark,
+				Content:        ot.Content,
+				Mark:           ot.Mark,
+				MatchMode:      ot.MatchMode,
+				MatchThreshold: ot.MatchThreshold,
+				Type:           t,
+				QuestionID:     qid,
+			})
+		}
+		sort.Sort(ByTAOrder(answers))
+		return answers, nil
+	case util.Numeric:
+		ons, err := s.Repository.GetNumericOptionHistoriesByQuestionID(c, qid)
+		if err != nil {
+			return nil, err
+		}
+		answers := make([]LQSNumericAnswer, 0)
+		for _, on := range ons {
+			answers = append(answers, LQSNumericAnswer{
+				LQSNumericOption: LQSNumericOption{
+					ID:    on.ID,
+					Unit:  on.Unit,
+					Order: on.Order,
+				},
+				Value:      on.Value,
+				Tolerance:  on.Tolerance,
+				MinValue:   on.MinValue,
+				MaxValue:   on.MaxValue,
+				Mark:       on.Mark,
 				Type:       t,
 				QuestionID: qid,
 			})
 		}
-		sort.Sort(ByTAOrder(answers))
+		sort.Sort(ByNAOrder(answers))
+		return answers, nil
+	case util.Slider:
+		oss, err := s.Repository.GetSliderOptionHistoriesByQuestionID(c, qid)
+		if err != nil {
+			return nil, err
+		}
+		answers := make([]LQSSliderAnswer, 0)
+		for _, os := range oss {
+			answers = append(answers, LQSSliderAnswer{
+				LQSSliderOption: LQSSliderOption{
+					ID:       os.ID,
+					MinValue: os.MinValue,
+					MaxValue: os.MaxValue,
+					Step:     os.Step,
+					Order:    os.Order,
+				},
+				Target:     os.Target,
+				Tolerance:  os.Tolerance,
+				Falloff:    os.Falloff,
+				Mark:       os.Mark,
+				Type:       t,
+				QuestionID: qid,
+			})
+		}
+		sort.Sort(BySAOrder(answers))
+		return answers, nil
+	case util.Ordering:
+		oos, err := s.Repository.GetOrderingOptionHistoriesByQuestionID(c, qid)
+		if err != nil {
+			return nil, err
+		}
+		answers := make([]LQSOrderingAnswer, 0)
+		for _, oo := range oos {
+			answers = append(answers, LQSOrderingAnswer{
+				LQSOrderingOption: LQSOrderingOption{
+					ID:      oo.ID,
+					Content: oo.Content,
+					Color:   oo.Color,
+					Order:   oo.Order,
+				},
+				Mark:       oo.Mark,
+				Type:       t,
+				QuestionID: qid,
+			})
+		}
+		sort.Sort(ByOAOrder(answers))
+		return answers, nil
+	case util.WordCloud:
+		return make([]any, 0), nil
+	case util.Hotspot:
+		ohs, err := s.Repository.GetHotspotOptionHistoriesByQuestionID(c, qid)
+		if err != nil {
+			return nil, err
+		}
+		answers := make([]LQSHotspotAnswer, 0)
+		for _, oh := range ohs {
+			answers = append(answers, LQSHotspotAnswer{
+				ID:         oh.ID,
+				Shape:      oh.Shape,
+				X:          oh.X,
+				Y:          oh.Y,
+				Width:      oh.Width,
+				Height:     oh.Height,
+				Radius:     oh.Radius,
+				Points:     oh.Points,
+				Order:      oh.Order,
+				Mark:       oh.Mark,
+				Type:       t,
+				QuestionID: qid,
+			})
+		}
+		sort.Sort(ByHAOrder(answers))
 		return answers, nil
 	case util.Matching:
 		oms, err := s.Repository.GetMatchingAnswerHistoriesByQuestionID(c, qid)
@@ -0,0 +1,23 @@
+package util
+
+import "strings"
+
+// IsAllowed tells whether an email is on an allowlist of emails and domains. A
+// domain may be given with or without its leading @.
+func IsAllowed(email string, allowlist []string) bool {
+	email = strings.ToLower(strings.TrimSpace(email))
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := email[at+1:]
+
+	for _, a := range allowlist {
+		a = strings.ToLower(strings.TrimSpace(a))
+		if a == email || strings.TrimPrefix(a, "@") == domain {
+			return true
+		}
+	}
+
+	return false
+}
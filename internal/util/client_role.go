@@ -0,0 +1,6 @@
+package util
+
+const (
+	CoHostRole    = "CO_HOST"
+	ModeratorRole = "MODERATOR"
+)
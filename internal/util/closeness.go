@@ -0,0 +1,47 @@
+package util
+
+import "math"
+
+// SnapToStep moves a slider value onto the nearest step between min and max.
+// A value that is not a number lands on the lower end.
+func SnapToStep(value float64, min float64, max float64, step float64) float64 {
+	lo, hi := math.Min(min, max), math.Max(min, max)
+	if math.IsNaN(value) {
+		return lo
+	}
+	value = math.Max(lo, math.Min(hi, value))
+	if step > 0 {
+		// The last step may fall short of max, and nothing goes past it.
+		n := math.Min(math.Round((value-lo)/step), math.Floor((hi-lo)/step))
+		value = lo + n*step
+	}
+	return value
+}
+
+// Closeness is the share of the marks, from 0 to 1, a slider answer earns for
+// how near it is to the target. Tolerance and falloff are in percent of the
+// slider range: answers within tolerance get full marks, and the share then
+// drops linearly to zero over the next falloff. Without a falloff it drops
+// over the rest of the range.
+func Closeness(value float64, target float64, min float64, max float64, tolerance float64, falloff float64) float64 {
+	span := math.Abs(max - min)
+	if span == 0 {
+		if value == target {
+			return 1
+		}
+		return 0
+	}
+
+	distance := math.Abs(value-target) / span * 100
+	if distance <= tolerance {
+		return 1
+	}
+	if falloff <= 0 {
+		falloff = 100 - tolerance
+	}
+	if falloff <= 0 {
+		return 0
+	}
+
+	return math.Max(0, 1-(distance-tolerance)/falloff)
+}
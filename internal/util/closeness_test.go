@@ -0,0 +1,71 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapToStep(t *testing.T) {
+	tests := []struct {
+		name  string
+		value float64
+		min   float64
+		max   float64
+		step  float64
+		want  float64
+	}{
+		{"on a step", 30, 0, 100, 10, 30},
+		{"rounds down", 34, 0, 100, 10, 30},
+		{"rounds up", 35, 0, 100, 10, 40},
+		{"steps start at min", 7, 5, 100, 10, 5},
+		{"below min", -20, 0, 100, 10, 0},
+		{"above max", 120, 0, 100, 10, 100},
+		{"reversed range", 64, 100, 0, 25, 75},
+		{"no step only clamps", 33.3, 0, 100, 0, 33.3},
+		{"nearest step past max", 100, 0, 100, 40, 80},
+		{"infinity", math.Inf(1), 0, 100, 10, 100},
+		{"negative infinity", math.Inf(-1), 0, 100, 10, 0},
+		{"not a number", math.NaN(), 0, 100, 10, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, SnapToStep(tt.value, tt.min, tt.max, tt.step), 1e-9)
+		})
+	}
+}
+
+func TestCloseness(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     float64
+		target    float64
+		min       float64
+		max       float64
+		tolerance float64
+		falloff   float64
+		want      float64
+	}{
+		{"on target", 50, 50, 0, 100, 0, 0, 1},
+		{"within tolerance", 45, 50, 0, 100, 10, 20, 1},
+		{"at the tolerance", 60, 50, 0, 100, 10, 20, 1},
+		{"halfway through the falloff", 70, 50, 0, 100, 10, 20, 0.5},
+		{"at the end of the falloff", 80, 50, 0, 100, 10, 20, 0},
+		{"beyond the falloff", 95, 50, 0, 100, 10, 20, 0},
+		{"falloff over the rest of the range", 30, 80, 0, 100, 0, 0, 0.5},
+		{"at the far end of the range", 0, 100, 0, 100, 0, 0, 0},
+		{"distance relative to the range", 210, 200, 100, 300, 5, 10, 1},
+		{"reversed range", 75, 50, 100, 0, 0, 50, 0.5},
+		{"tolerance covers the range", 0, 100, 0, 100, 100, 0, 1},
+		{"no range on target", 5, 5, 5, 5, 0, 0, 1},
+		{"no range off target", 6, 5, 5, 5, 50, 50, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, Closeness(tt.value, tt.target, tt.min, tt.max, tt.tolerance, tt.falloff), 1e-9)
+		})
+	}
+}
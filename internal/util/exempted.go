@@ -0,0 +1,22 @@
+package util
+
+import "strings"
+
+// ExemptedIDs reads the IDs of the questions voided in a session, which are
+// kept on the session as a comma separated list.
+func ExemptedIDs(s *string) []string {
+	if s == nil || *s == "" {
+		return []string{}
+	}
+	return strings.Split(*s, ",")
+}
+
+// JoinExemptedIDs turns the IDs of the voided questions back into the form
+// they are kept in.
+func JoinExemptedIDs(ids []string) *string {
+	if len(ids) == 0 {
+		return nil
+	}
+	s := strings.Join(ids, ",")
+	return &s
+}
@@ -0,0 +1,85 @@
+package util
+
+import (
+	"encoding/json"
+	"math"
+)
+
+const (
+	HotspotRectangle = "RECTANGLE"
+	HotspotCircle    = "CIRCLE"
+	HotspotPolygon   = "POLYGON"
+)
+
+// Point is a position on a question image, normalized so that both
+// coordinates run from 0 to 1 across the image.
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Region is a correct area of a hotspot question. Rectangles span Width and
+// Height from their top left corner at X and Y, circles reach Radius around
+// their centre at X and Y, and polygons join Points in order.
+type Region struct {
+	Shape  string
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+	Radius float64
+	Points []Point
+}
+
+// ParsePoints reads the vertices of a polygon, stored as a JSON list of
+// points.
+func ParsePoints(s string) []Point {
+	var points []Point
+	if err := json.Unmarshal([]byte(s), &points); err != nil {
+		return nil
+	}
+	return points
+}
+
+// Contains tells whether a click at p falls inside the region. Points on the
+// edge count as inside.
+func (r Region) Contains(p Point) bool {
+	switch r.Shape {
+	case HotspotRectangle:
+		return p.X >= r.X && p.X <= r.X+r.Width && p.Y >= r.Y && p.Y <= r.Y+r.Height
+	case HotspotCircle:
+		return math.Hypot(p.X-r.X, p.Y-r.Y) <= r.Radius
+	case HotspotPolygon:
+		return inPolygon(p, r.Points)
+	}
+	return false
+}
+
+// inPolygon casts a ray from p to the right and counts the edges it crosses.
+func inPolygon(p Point, points []Point) bool {
+	if len(points) < 3 {
+		return false
+	}
+
+	inside := false
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		a, b := points[i], points[j]
+		if onSegment(p, a, b) {
+			return true
+		}
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func onSegment(p Point, a Point, b Point) bool {
+	const epsilon = 1e-9
+	cross := (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+	if math.Abs(cross) > epsilon {
+		return false
+	}
+	return p.X >= math.Min(a.X, b.X)-epsilon && p.X <= math.Max(a.X, b.X)+epsilon &&
+		p.Y >= math.Min(a.Y, b.Y)-epsilon && p.Y <= math.Max(a.Y, b.Y)+epsilon
+}
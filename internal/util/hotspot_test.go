@@ -0,0 +1,66 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegionContains(t *testing.T) {
+	rectangle := Region{Shape: HotspotRectangle, X: 0.25, Y: 0.25, Width: 0.5, Height: 0.25}
+	circle := Region{Shape: HotspotCircle, X: 0.5, Y: 0.5, Radius: 0.25}
+	triangle := Region{Shape: HotspotPolygon, Points: []Point{{0, 0}, {1, 0}, {0, 1}}}
+	// An L shape, so that the notch at the top right is outside.
+	ell := Region{Shape: HotspotPolygon, Points: []Point{{0, 0}, {0.5, 0}, {0.5, 0.5}, {1, 0.5}, {1, 1}, {0, 1}}}
+
+	tests := []struct {
+		name   string
+		region Region
+		point  Point
+		want   bool
+	}{
+		{"inside a rectangle", rectangle, Point{0.5, 0.375}, true},
+		{"on a rectangle corner", rectangle, Point{0.75, 0.5}, true},
+		{"on a rectangle edge", rectangle, Point{0.25, 0.3}, true},
+		{"just outside a rectangle", rectangle, Point{0.5, 0.51}, false},
+		{"circle centre", circle, Point{0.5, 0.5}, true},
+		{"on a circle", circle, Point{0.5, 0.75}, true},
+		{"just outside a circle", circle, Point{0.5, 0.76}, false},
+		{"inside the bounds but outside a circle", circle, Point{0.7, 0.7}, false},
+		{"inside a triangle", triangle, Point{0.25, 0.25}, true},
+		{"on a triangle vertex", triangle, Point{1, 0}, true},
+		{"on the slanted edge", triangle, Point{0.5, 0.5}, true},
+		{"beyond the slanted edge", triangle, Point{0.6, 0.6}, false},
+		{"inside an L", ell, Point{0.25, 0.75}, true},
+		{"in the notch of an L", ell, Point{0.75, 0.25}, false},
+		{"on the inner corner of an L", ell, Point{0.5, 0.5}, true},
+		{"level with a vertex", ell, Point{0.25, 0.5}, true},
+		{"polygon with two points", Region{Shape: HotspotPolygon, Points: []Point{{0, 0}, {1, 1}}}, Point{0.5, 0.5}, false},
+		{"unknown shape", Region{Shape: "", X: 0, Y: 0, Width: 1, Height: 1}, Point{0.5, 0.5}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.region.Contains(tt.point))
+		})
+	}
+}
+
+func TestParsePoints(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []Point
+	}{
+		{"points", `[{"x":0.1,"y":0.2},{"x":0.3,"y":0.4}]`, []Point{{0.1, 0.2}, {0.3, 0.4}}},
+		{"empty list", `[]`, []Point{}},
+		{"not json", `0.1,0.2`, nil},
+		{"empty", ``, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParsePoints(tt.s))
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package util
+
+const (
+	JoinPending  = "PENDING"
+	JoinApproved = "APPROVED"
+	JoinRejected = "REJECTED"
+)
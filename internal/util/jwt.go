@@ -1,6 +1,7 @@
 package util
 
 import (
+	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -54,3 +55,49 @@ func DecodeToken(tokenString string, secret string) (*Claims, error) {
 
 	return claims, nil
 }
+
+// RejoinClaims let a client back into the session it joined, as the same
+// participant. They are no good for any other session.
+type RejoinClaims struct {
+	ParticipantID     uuid.UUID `json:"pid"`
+	LiveQuizSessionID uuid.UUID `json:"lqs_id"`
+	jwt.StandardClaims
+}
+
+func GenerateRejoinToken(pid uuid.UUID, lqsID uuid.UUID, duration time.Time, secret string) (string, error) {
+	claims := &RejoinClaims{
+		ParticipantID:     pid,
+		LiveQuizSessionID: lqsID,
+		StandardClaims: jwt.StandardClaims{
+			Issuer:    lqsID.String(),
+			ExpiresAt: duration.Unix(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString([]byte(secret))
+	if err != nil {
+		return "", err
+	}
+
+	return tokenString, nil
+}
+
+func DecodeRejoinToken(tokenString string, secret string) (*RejoinClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &RejoinClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(secret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*RejoinClaims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid rejoin token")
+	}
+
+	return claims, nil
+}
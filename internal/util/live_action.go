@@ -2,8 +2,15 @@ package util
 
 const (
 	JoinLQS         = "JOIN_LQS"
+	RejoinToken     = "REJOIN_TOKEN"
+	JoinRequest     = "JOIN_REQUEST"
+	ApproveJoin     = "APPROVE_JOIN"
+	RejectJoin      = "REJECT_JOIN"
 	LeaveLQS        = "LEAVE_LQS"
 	KickParticipant = "KICK_PARTICIPANT"
+	AssignRole      = "ASSIGN_ROLE"
+	RevokeRole      = "REVOKE_ROLE"
+	OpenLobby       = "OPEN_LOBBY"
 	StartLQS        = "START_LQS"
 	EndLQS          = "END_LQS"
 	NextQuestion    = "NEXT_QUESTION"
@@ -16,8 +23,14 @@ const (
 
 	ToggleLock      = "TOGGLE_LOCK"
 	Intterrupt      = "INTERRUPT"
+	PauseTimer      = "PAUSE_TIMER"
+	ResumeTimer     = "RESUME_TIMER"
+	ExtendTimer     = "EXTEND_TIMER"
 	GetParticipants = "GET_PARTICIPANTS"
 	UpdateMarks     = "UPDATE_MARKS"
+	OverrideMarks   = "OVERRIDE_MARKS"
+	VoidQuestion    = "VOID_QUESTION"
+	UpdateProgress  = "UPDATE_PROGRESS"
 	SubmitAnswer    = "SUBMIT_ANSWER"
 	UnsubmitAnswer  = "UNSUBMIT_ANSWER"
 	GetLeaderboard  = "GET_LEADERBOARD"
@@ -1,6 +1,7 @@
 package util
 
 const (
+	Scheduled       = "SCHEDULED"
 	Idle            = "IDLE"
 	Starting        = "STARTING"
 	Ending          = "ENDING"
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseNumber reads a participant's numeric answer, allowing it to be followed
+// by the expected unit and to use commas as thousands separators.
+func ParseNumber(s string, unit string) (float64, bool) {
+	s = strings.TrimSpace(s)
+	if unit != "" && len(s) >= len(unit) && strings.EqualFold(s[len(s)-len(unit):], unit) {
+		s = strings.TrimSpace(s[:len(s)-len(unit)])
+	}
+	s = strings.ReplaceAll(s, ",", "")
+
+	// ParseFloat also reads "NaN" and "Inf", which no answer can match.
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+	return v, true
+}
+
+// MatchNumber tells whether a numeric answer is accepted. An option with a
+// range (min below max) accepts anything inside it, otherwise the answer has
+// to be within tolerance of the value.
+func MatchNumber(answer float64, value float64, tolerance float64, min float64, max float64) bool {
+	if min < max {
+		return answer >= min && answer <= max
+	}
+	if tolerance < 0 {
+		tolerance = -tolerance
+	}
+	return answer >= value-tolerance && answer <= value+tolerance
+}
@@ -0,0 +1,72 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		unit   string
+		want   float64
+		wantOK bool
+	}{
+		{"plain number", "42", "", 42, true},
+		{"surrounding whitespace", "  -3.5 ", "", -3.5, true},
+		{"thousands separators", "1,234,567.8", "", 1234567.8, true},
+		{"with the unit", "12 kg", "kg", 12, true},
+		{"unit without a space", "12kg", "kg", 12, true},
+		{"unit in another case", "12 KG", "kg", 12, true},
+		{"unit on its own", "kg", "kg", 0, false},
+		{"another unit", "12 lb", "kg", 0, false},
+		{"unit when none is expected", "12 kg", "", 0, false},
+		{"not a number", "twelve", "", 0, false},
+		{"NaN", "NaN", "", 0, false},
+		{"infinity", "Inf", "", 0, false},
+		{"negative infinity", "-infinity", "", 0, false},
+		{"out of range", "1e400", "", 0, false},
+		{"empty", "", "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseNumber(tt.s, tt.unit)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatchNumber(t *testing.T) {
+	tests := []struct {
+		name      string
+		answer    float64
+		value     float64
+		tolerance float64
+		min       float64
+		max       float64
+		want      bool
+	}{
+		{"exact value", 10, 10, 0, 0, 0, true},
+		{"off without tolerance", 10.1, 10, 0, 0, 0, false},
+		{"at the lower tolerance", 9.5, 10, 0.5, 0, 0, true},
+		{"at the upper tolerance", 10.5, 10, 0.5, 0, 0, true},
+		{"beyond tolerance", 10.6, 10, 0.5, 0, 0, false},
+		{"negative tolerance counts as positive", 9.6, 10, -0.5, 0, 0, true},
+		{"at the range minimum", 5, 10, 0, 5, 15, true},
+		{"at the range maximum", 15, 10, 0, 5, 15, true},
+		{"below the range", 4.9, 10, 0, 5, 15, false},
+		{"range wins over tolerance", 20, 10, 100, 5, 15, false},
+		{"empty range falls back to tolerance", 11, 10, 1, 15, 15, true},
+		{"reversed range falls back to tolerance", 11, 10, 0, 15, 5, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchNumber(tt.answer, tt.value, tt.tolerance, tt.min, tt.max))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package util
+
+// Ordering questions keep their grading mode in the same field as the
+// multi-select grading of choice questions.
+const (
+	OrderExact    = "EXACT_ORDER"
+	OrderPosition = "POSITION"
+)
+
+type OrderItem struct {
+	Mark     int
+	Position int
+	Picked   int
+}
+
+// GradeOrder works out the marks for the order a participant put the items of
+// an ordering question in. Position and Picked are the right and the given
+// position of an item, counting from 1, and Picked is 0 for an item left out.
+//
+//   - EXACT_ORDER gives the marks of all items for putting every item in its
+//     place, and nothing otherwise.
+//   - POSITION, the default, adds up the marks of every item in its place.
+func GradeOrder(mode string, items []OrderItem) (float64, bool) {
+	total, marks := 0, 0
+	isCorrect := len(items) > 0
+	for _, i := range items {
+		total += i.Mark
+		if i.Picked == i.Position {
+			marks += i.Mark
+		} else {
+			isCorrect = false
+		}
+	}
+
+	if mode == OrderExact {
+		if isCorrect {
+			return float64(total), true
+		}
+		return 0, false
+	}
+
+	return float64(marks), isCorrect
+}
@@ -0,0 +1,50 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGradeOrder(t *testing.T) {
+	// Three items worth 1, 2 and 3, picked at the given positions.
+	items := func(picked ...int) []OrderItem {
+		items := []OrderItem{
+			{Mark: 1, Position: 1},
+			{Mark: 2, Position: 2},
+			{Mark: 3, Position: 3},
+		}
+		for i, p := range picked {
+			items[i].Picked = p
+		}
+		return items
+	}
+
+	tests := []struct {
+		name        string
+		mode        string
+		items       []OrderItem
+		wantMarks   float64
+		wantCorrect bool
+	}{
+		{"position with every item in place", OrderPosition, items(1, 2, 3), 6, true},
+		{"position with two swapped", OrderPosition, items(2, 1, 3), 3, false},
+		{"position with duplicate picks", OrderPosition, items(1, 1, 3), 4, false},
+		{"position with every item on one spot", OrderPosition, items(2, 2, 2), 2, false},
+		{"position with an item left out", OrderPosition, items(1, 2), 3, false},
+		{"no mode grades by position", "", items(3, 2, 1), 2, false},
+		{"exact with every item in place", OrderExact, items(1, 2, 3), 6, true},
+		{"exact with one out of place", OrderExact, items(1, 3, 2), 0, false},
+		{"exact with duplicate picks", OrderExact, items(1, 2, 2), 0, false},
+		{"no items", OrderPosition, nil, 0, false},
+		{"no items in exact order", OrderExact, nil, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			marks, isCorrect := GradeOrder(tt.mode, tt.items)
+			assert.Equal(t, tt.wantMarks, marks)
+			assert.Equal(t, tt.wantCorrect, isCorrect)
+		})
+	}
+}
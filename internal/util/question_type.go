@@ -7,6 +7,18 @@ const (
 	Paragraph = "PARAGRAPH"
 	FillBlank = "FILL_BLANK"
 	Matching  = "MATCHING"
+	Numeric   = "NUMERIC"
+	Ordering  = "ORDERING"
+	Hotspot   = "HOTSPOT"
+	Slider    = "SLIDER"
+	Poll      = "POLL"
+	WordCloud = "WORD_CLOUD"
 
 	AnswerSplitter = "<!#XyZ@?>"
 )
+
+// IsGraded tells whether answers to a question of the given type are scored.
+// Polls and word clouds only collect opinions.
+func IsGraded(t string) bool {
+	return t != Poll && t != WordCloud
+}
@@ -0,0 +1,8 @@
+package util
+
+const (
+	SchedulePending   = "PENDING"
+	ScheduleOpen      = "OPEN"
+	ScheduleStarted   = "STARTED"
+	ScheduleCancelled = "CANCELLED"
+)
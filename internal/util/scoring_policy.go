@@ -0,0 +1,9 @@
+package util
+
+const (
+	LinearTimeBonus  = "LINEAR_TIME_BONUS"
+	ExponentialDecay = "EXPONENTIAL_DECAY"
+	FixedPoints      = "FIXED_POINTS"
+	StreakMultiplier = "STREAK_MULTIPLIER"
+	NegativeMarking  = "NEGATIVE_MARKING"
+)
@@ -0,0 +1,75 @@
+package util
+
+import "math"
+
+const (
+	SelectSum          = "SUM"
+	SelectAllOrNothing = "ALL_OR_NOTHING"
+	SelectPartial      = "PARTIAL"
+	SelectPenalty      = "PENALTY"
+)
+
+type SelectOption struct {
+	Mark    int
+	Correct bool
+	Picked  bool
+}
+
+// IsSelectGraded tells whether a multi-select question is graded as a whole
+// rather than by adding up the marks of every picked option.
+func IsSelectGraded(mode string) bool {
+	return mode == SelectAllOrNothing || mode == SelectPartial || mode == SelectPenalty
+}
+
+// GradeSelection works out the marks for the options picked in a multi-select
+// question, out of the marks of all its correct options.
+//
+//   - SUM adds up the marks of every picked option.
+//   - ALL_OR_NOTHING gives everything for picking exactly the correct options.
+//   - PARTIAL gives the share of correct options picked, less the share of
+//     wrong options picked, and never goes below zero.
+//   - PENALTY gives the share of correct options picked, less one share for
+//     every wrong option picked, down to minus the full marks.
+func GradeSelection(mode string, options []SelectOption) float64 {
+	total, corrects, wrongs := 0, 0, 0
+	pickedCorrects, pickedWrongs, picked := 0, 0, 0
+	for _, o := range options {
+		if o.Correct {
+			total += o.Mark
+			corrects++
+		} else {
+			wrongs++
+		}
+		if o.Picked {
+			picked += o.Mark
+			if o.Correct {
+				pickedCorrects++
+			} else {
+				pickedWrongs++
+			}
+		}
+	}
+
+	if corrects == 0 || !IsSelectGraded(mode) {
+		return float64(picked)
+	}
+
+	var share float64
+	switch mode {
+	case SelectAllOrNothing:
+		if pickedCorrects == corrects && pickedWrongs == 0 {
+			share = 1
+		}
+	case SelectPartial:
+		share = float64(pickedCorrects) / float64(corrects)
+		if wrongs > 0 {
+			share -= float64(pickedWrongs) / float64(wrongs)
+		}
+		share = math.Max(share, 0)
+	case SelectPenalty:
+		share = float64(pickedCorrects-pickedWrongs) / float64(corrects)
+		share = math.Max(share, -1)
+	}
+
+	return share * float64(total)
+}
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGradeSelection(t *testing.T) {
+	// Three correct options worth 10 altogether and two wrong ones.
+	options := func(picked ...bool) []SelectOption {
+		opts := []SelectOption{
+			{Mark: 4, Correct: true},
+			{Mark: 3, Correct: true},
+			{Mark: 3, Correct: true},
+			{Mark: 0, Correct: false},
+			{Mark: 0, Correct: false},
+		}
+		for i, p := range picked {
+			opts[i].Picked = p
+		}
+		return opts
+	}
+
+	tests := []struct {
+		name    string
+		mode    string
+		options []SelectOption
+		want    float64
+	}{
+		{"sum of picked marks", SelectSum, options(true, false, true), 7},
+		{"unknown mode adds up", "", options(true, true, false), 7},
+		{"all or nothing with every correct option", SelectAllOrNothing, options(true, true, true), 10},
+		{"all or nothing missing one", SelectAllOrNothing, options(true, true, false), 0},
+		{"all or nothing with a wrong one", SelectAllOrNothing, options(true, true, true, true), 0},
+		{"partial with a third", SelectPartial, options(true), 10.0 / 3},
+		{"partial with two thirds", SelectPartial, options(true, true), 20.0 / 3},
+		{"partial less half a wrong share", SelectPartial, options(true, true, true, true), 5},
+		{"partial never goes below zero", SelectPartial, options(true, false, false, true, true), 0},
+		{"penalty with a wrong one", SelectPenalty, options(true, true, false, true), 10.0 / 3},
+		{"penalty goes below zero", SelectPenalty, options(false, false, false, true, true), -20.0 / 3},
+		{"nothing picked", SelectPartial, options(), 0},
+		{"no correct options adds up", SelectAllOrNothing, []SelectOption{{Mark: 2, Picked: true}}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, GradeSelection(tt.mode, tt.options), 1e-9)
+		})
+	}
+}
+
+func TestGradeSelectionPenaltyFloor(t *testing.T) {
+	options := []SelectOption{
+		{Mark: 6, Correct: true},
+		{Mark: 0, Correct: false, Picked: true},
+		{Mark: 0, Correct: false, Picked: true},
+		{Mark: 0, Correct: false, Picked: true},
+	}
+
+	assert.Equal(t, -6.0, GradeSelection(SelectPenalty, options))
+}
@@ -0,0 +1,6 @@
+package util
+
+const (
+	LiveMode       = "LIVE"
+	AssignmentMode = "ASSIGNMENT"
+)
@@ -18,3 +18,22 @@ func ShuffleNumbers(max int) []int {
 
 	return numbers
 }
+
+// ShuffleNumbersWithSeed is ShuffleNumbers with a reproducible order, so the
+// same seed always yields the same permutation.
+func ShuffleNumbersWithSeed(max int, seed int64) []int {
+	r := rand.New(rand.NewSource(seed))
+
+	numbers := make([]int, max)
+	for i := 0; i < max; i++ {
+		numbers[i] = i + 1
+	}
+
+	// Fisher-Yates shuffle algorithm
+	for i := len(numbers) - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		numbers[i], numbers[j] = numbers[j], numbers[i]
+	}
+
+	return numbers
+}
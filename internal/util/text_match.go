@@ -0,0 +1,94 @@
+package util
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	MatchExact       = "EXACT"
+	MatchNormalized  = "NORMALIZED"
+	MatchLevenshtein = "LEVENSHTEIN"
+	MatchRegex       = "REGEX"
+	MatchNumeric     = "NUMERIC"
+)
+
+// MatchText tells whether a participant's text answer matches the expected
+// content under the given match mode.
+//
+//   - EXACT (or no mode) compares the texts as they are.
+//   - NORMALIZED trims the texts and collapses their inner whitespace.
+//   - LEVENSHTEIN accepts answers at most threshold edits away from the
+//     normalized content.
+//   - REGEX treats the content as a pattern the whole answer has to match.
+//   - NUMERIC parses both as numbers and accepts answers within threshold.
+//
+// Every mode but NUMERIC honours caseSensitive.
+func MatchText(mode string, threshold float64, caseSensitive bool, content string, answer string) bool {
+	switch mode {
+	case MatchNormalized:
+		return equalText(normalizeText(content), normalizeText(answer), caseSensitive)
+	case MatchLevenshtein:
+		c, a := normalizeText(content), normalizeText(answer)
+		if !caseSensitive {
+			c, a = strings.ToLower(c), strings.ToLower(a)
+		}
+		return float64(levenshtein(c, a)) <= threshold
+	case MatchRegex:
+		pattern := "^(?:" + content + ")$"
+		if !caseSensitive {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false
+		}
+		return re.MatchString(strings.TrimSpace(answer))
+	case MatchNumeric:
+		c, err := strconv.ParseFloat(strings.TrimSpace(content), 64)
+		if err != nil {
+			return false
+		}
+		a, err := strconv.ParseFloat(strings.TrimSpace(answer), 64)
+		if err != nil {
+			return false
+		}
+		return math.Abs(c-a) <= math.Abs(threshold)
+	default:
+		return equalText(content, answer, caseSensitive)
+	}
+}
+
+func equalText(a string, b string, caseSensitive bool) bool {
+	if caseSensitive {
+		return a == b
+	}
+	return strings.EqualFold(a, b)
+}
+
+func normalizeText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func levenshtein(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,67 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchText(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          string
+		threshold     float64
+		caseSensitive bool
+		content       string
+		answer        string
+		want          bool
+	}{
+		{"exact match", MatchExact, 0, true, "Bangkok", "Bangkok", true},
+		{"exact is case sensitive", MatchExact, 0, true, "Bangkok", "bangkok", false},
+		{"exact ignoring case", MatchExact, 0, false, "Bangkok", "BANGKOK", true},
+		{"exact keeps whitespace", MatchExact, 0, false, "Bangkok", " Bangkok", false},
+		{"no mode is exact", "", 0, false, "Bangkok", "bangkok", true},
+		{"normalized trims and collapses", MatchNormalized, 0, true, "New York", "  New   York ", true},
+		{"normalized is case sensitive", MatchNormalized, 0, true, "New York", "new york", false},
+		{"normalized ignoring case", MatchNormalized, 0, false, "New York", " new\tYORK", true},
+		{"levenshtein within threshold", MatchLevenshtein, 3, false, "Mississippi", "Misisipi", true},
+		{"levenshtein beyond threshold", MatchLevenshtein, 2, false, "Mississippi", "Misisipi", false},
+		{"levenshtein counts case", MatchLevenshtein, 0, true, "Paris", "paris", false},
+		{"levenshtein ignoring case", MatchLevenshtein, 0, false, "Paris", "PARIS", true},
+		{"levenshtein counts runes", MatchLevenshtein, 1, true, "café", "cafe", true},
+		{"regex matches the whole answer", MatchRegex, 0, true, "colou?r", "color", true},
+		{"regex does not match a part", MatchRegex, 0, true, "colou?r", "colors", false},
+		{"regex ignoring case", MatchRegex, 0, false, "colou?r", " COLOUR ", true},
+		{"invalid regex never matches", MatchRegex, 0, false, "colou(r", "colour", false},
+		{"numeric within threshold", MatchNumeric, 0.01, false, "3.14", " 3.141 ", true},
+		{"numeric beyond threshold", MatchNumeric, 0.001, false, "3.14", "3.142", false},
+		{"numeric with negative threshold", MatchNumeric, -0.5, false, "10", "10.5", true},
+		{"numeric rejects text", MatchNumeric, 1, false, "10", "ten", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchText(tt.mode, tt.threshold, tt.caseSensitive, tt.content, tt.answer))
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"กข", "กค", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, levenshtein(tt.a, tt.b))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeWord turns a word cloud entry into the form it is counted under,
+// so that "Fun!", " fun" and "FUN" all land on "fun".
+func NormalizeWord(s string) string {
+	s = strings.TrimFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	})
+	return strings.ToLower(normalizeText(s))
+}